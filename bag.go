@@ -0,0 +1,116 @@
+package money
+
+import (
+	"errors"
+	"sort"
+)
+
+// Bag holds amounts of several currencies, keeping one running total per currency code.
+// The zero value is an empty Bag ready to use. A Bag is not safe for concurrent use.
+type Bag struct {
+	totals map[string]*Money
+}
+
+// NewBag creates new Bag holding the sum of given Money.
+func NewBag(ms ...*Money) (*Bag, error) {
+	b := &Bag{}
+	if err := b.Add(ms...); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// Add adds given Money to the totals of their currencies.
+func (b *Bag) Add(ms ...*Money) error {
+	return b.apply(ms, mutate.calc.add)
+}
+
+// Subtract subtracts given Money from the totals of their currencies.
+func (b *Bag) Subtract(ms ...*Money) error {
+	return b.apply(ms, mutate.calc.subtract)
+}
+
+func (b *Bag) apply(ms []*Money, op func(a, b Amount) Amount) error {
+	for _, m := range ms {
+		if m.currency == nil || m.currency.Code == "" {
+			return errors.New("no currency found")
+		}
+	}
+
+	if b.totals == nil {
+		b.totals = make(map[string]*Money)
+	}
+
+	for _, m := range ms {
+		total, ok := b.totals[m.currency.Code]
+		if !ok {
+			total = &Money{currency: m.currency}
+		}
+		b.totals[m.currency.Code] = &Money{amount: op(total.amount, m.amount), currency: total.currency}
+	}
+
+	return nil
+}
+
+// Get returns the total of the given currency, zero if the Bag doesn't hold it.
+func (b *Bag) Get(code string) *Money {
+	if m, ok := b.totals[newCurrency(code).Code]; ok {
+		return m
+	}
+
+	return New(0, code)
+}
+
+// Currencies returns the codes of the currencies held by the Bag in alphabetical order.
+func (b *Bag) Currencies() []string {
+	codes := make([]string, 0, len(b.totals))
+	for code := range b.totals {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	return codes
+}
+
+// Moneys returns the total of every currency held by the Bag ordered by currency code.
+func (b *Bag) Moneys() []*Money {
+	ms := make([]*Money, 0, len(b.totals))
+	for _, code := range b.Currencies() {
+		ms = append(ms, b.totals[code])
+	}
+
+	return ms
+}
+
+// Len returns the number of currencies held by the Bag.
+func (b *Bag) Len() int {
+	return len(b.totals)
+}
+
+// IsZero returns boolean of whether the totals of all currencies are equal to zero.
+func (b *Bag) IsZero() bool {
+	for _, m := range b.totals {
+		if !m.IsZero() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Total collapses the Bag into a single currency converting every total using conv.
+func (b *Bag) Total(to string, conv Converter) (*Money, error) {
+	total := New(0, to)
+	for _, m := range b.Moneys() {
+		if m.currency.Code != total.currency.Code {
+			var err error
+			if m, err = conv.Convert(m, to); err != nil {
+				return nil, err
+			}
+		}
+		total = &Money{amount: mutate.calc.add(total.amount, m.amount), currency: total.currency}
+	}
+
+	return total, nil
+}
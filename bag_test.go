@@ -0,0 +1,98 @@
+package money
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBag_Add(t *testing.T) {
+	b, err := NewBag(New(1, USD), New(2, EUR), New("0.50", USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Add(New(3, GBP)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := b.Subtract(New(1, EUR), New("0.25", USD)); err != nil {
+		t.Fatal(err)
+	}
+
+	var rs []string
+	for _, m := range b.Moneys() {
+		rs = append(rs, m.Display())
+	}
+
+	expected := []string{"€1.00", "£3.00", "$1.25"}
+	if !reflect.DeepEqual(expected, rs) {
+		t.Errorf("Expected %v got %v", expected, rs)
+	}
+
+	if !reflect.DeepEqual([]string{EUR, GBP, USD}, b.Currencies()) {
+		t.Errorf("Expected %v got %v", []string{EUR, GBP, USD}, b.Currencies())
+	}
+
+	if b.Get("usd").Display() != "$1.25" || b.Get(JPY).Display() != "¥0" {
+		t.Errorf("Unexpected totals %s, %s", b.Get(USD).Display(), b.Get(JPY).Display())
+	}
+
+	if b.Len() != 3 {
+		t.Errorf("Expected %d got %d", 3, b.Len())
+	}
+}
+
+func TestBag_AddWithoutCurrency(t *testing.T) {
+	var b Bag
+
+	if err := b.Add(New(1, USD), &Money{}); err == nil {
+		t.Error("Expected err")
+	}
+
+	if b.Len() != 0 {
+		t.Errorf("Expected empty bag got %d currencies", b.Len())
+	}
+}
+
+func TestBag_IsZero(t *testing.T) {
+	var b Bag
+
+	if !b.IsZero() {
+		t.Error("Expected empty bag to be zero")
+	}
+
+	_ = b.Add(New(1, USD), New(0, EUR))
+	if b.IsZero() {
+		t.Error("Expected bag not to be zero")
+	}
+
+	_ = b.Subtract(New(1, USD))
+	if !b.IsZero() {
+		t.Error("Expected bag to be zero")
+	}
+}
+
+func TestBag_Total(t *testing.T) {
+	table := NewRateTable(EUR)
+	usd, _ := NewExchangeRate(EUR, USD, "1.25", time.Time{})
+	gbp, _ := NewExchangeRate(EUR, GBP, "0.80", time.Time{})
+	_ = table.Set(usd, gbp)
+
+	b, _ := NewBag(New(10, EUR), New(10, USD), New(8, GBP))
+
+	total, err := b.Total(EUR, table)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if total.Display() != "€28.00" {
+		t.Errorf("Expected %s got %s", "€28.00", total.Display())
+	}
+
+	_ = b.Add(New(1, CHF))
+	if _, err := b.Total(EUR, table); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("Expected ErrRateNotFound got %v", err)
+	}
+}
@@ -0,0 +1,105 @@
+package money
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// binaryVersion is the version of the binary format written by MarshalBinary.
+const binaryVersion = 1
+
+// ErrInvalidBinaryUnmarshal happens when UnmarshalBinary fails to decode Money because of invalid data.
+var ErrInvalidBinaryUnmarshal = errors.New("invalid binary unmarshal")
+
+// MarshalBinary is implementation of encoding.BinaryMarshaler. The format is the version byte,
+// the currency code prefixed by its uvarint length, the exponent of the amount as a varint and
+// the big-endian magnitude of its coefficient prefixed by its length as a varint, negated for
+// negative amounts. E.g. 12.50 USD takes 9 bytes.
+func (m Money) MarshalBinary() ([]byte, error) {
+	code := ""
+	if m.currency != nil {
+		code = m.currency.Code
+	}
+
+	coefficient := m.amount.Coefficient()
+	magnitude := coefficient.Bytes()
+	size := int64(len(magnitude))
+	if coefficient.Sign() < 0 {
+		size = -size
+	}
+
+	var buf [binary.MaxVarintLen64]byte
+	b := make([]byte, 0, 1+3*binary.MaxVarintLen64+len(code)+len(magnitude))
+	b = append(b, binaryVersion)
+	b = append(b, buf[:binary.PutUvarint(buf[:], uint64(len(code)))]...)
+	b = append(b, code...)
+	b = append(b, buf[:binary.PutVarint(buf[:], int64(m.amount.Exponent()))]...)
+	b = append(b, buf[:binary.PutVarint(buf[:], size)]...)
+	b = append(b, magnitude...)
+
+	return b, nil
+}
+
+// UnmarshalBinary is implementation of encoding.BinaryUnmarshaler, it reads the format written by MarshalBinary.
+func (m *Money) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return fmt.Errorf("%w: no data", ErrInvalidBinaryUnmarshal)
+	}
+	if data[0] != binaryVersion {
+		return fmt.Errorf("%w: unsupported version %d", ErrInvalidBinaryUnmarshal, data[0])
+	}
+	data = data[1:]
+
+	length, n := binary.Uvarint(data)
+	if n <= 0 || length > uint64(len(data)-n) {
+		return fmt.Errorf("%w: invalid currency code", ErrInvalidBinaryUnmarshal)
+	}
+	code := string(data[n : n+int(length)])
+	data = data[n+int(length):]
+
+	exponent, n := binary.Varint(data)
+	if n <= 0 || exponent < math.MinInt32 || exponent > math.MaxInt32 {
+		return fmt.Errorf("%w: invalid exponent", ErrInvalidBinaryUnmarshal)
+	}
+	data = data[n:]
+
+	size, n := binary.Varint(data)
+	magnitude := size
+	if magnitude < 0 {
+		magnitude = -magnitude
+	}
+	if n <= 0 || magnitude != int64(len(data)-n) {
+		return fmt.Errorf("%w: invalid coefficient", ErrInvalidBinaryUnmarshal)
+	}
+
+	coefficient := new(big.Int).SetBytes(data[n:])
+	if size < 0 {
+		coefficient.Neg(coefficient)
+	}
+
+	if code == "" && coefficient.Sign() == 0 {
+		*m = Money{}
+		return nil
+	}
+
+	*m = Money{
+		amount:   decimal.NewFromBigInt(coefficient, int32(exponent)),
+		currency: newCurrency(code).get(),
+	}
+	return nil
+}
+
+// GobEncode is implementation of gob.GobEncoder, it uses the MarshalBinary format.
+func (m Money) GobEncode() ([]byte, error) {
+	return m.MarshalBinary()
+}
+
+// GobDecode is implementation of gob.GobDecoder, it uses the MarshalBinary format.
+func (m *Money) GobDecode(data []byte) error {
+	return m.UnmarshalBinary(data)
+}
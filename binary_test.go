@@ -0,0 +1,91 @@
+package money
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+	"testing"
+)
+
+func TestMoney_MarshalBinary(t *testing.T) {
+	tcs := []*Money{
+		New("12.50", USD),
+		New("12.5", USD),
+		New("-0.125", USD),
+		New("12345678901234567890123456789.123456789", EUR),
+		New("-98765432109876543210", JPY),
+		New(0, GBP),
+		New("1.5", "XYZ"),
+	}
+
+	for _, tc := range tcs {
+		b, err := tc.MarshalBinary()
+		if err != nil {
+			t.Error(err)
+		}
+
+		var m Money
+		if err := m.UnmarshalBinary(b); err != nil {
+			t.Error(err)
+		}
+
+		if m.currency.Code != tc.currency.Code || m.amount.Exponent() != tc.amount.Exponent() || !m.amount.Equal(tc.amount) {
+			t.Errorf("Expected %s to round-trip got %s", tc, &m)
+		}
+	}
+}
+
+func TestMoney_MarshalBinarySize(t *testing.T) {
+	b, _ := New("12.50", USD).MarshalBinary()
+	expected := []byte{1, 3, 'U', 'S', 'D', 3, 4, 0x04, 0xe2}
+
+	if !bytes.Equal(b, expected) {
+		t.Errorf("Expected %v got %v", expected, b)
+	}
+
+	b, _ = Money{}.MarshalBinary()
+	var m Money
+	if err := m.UnmarshalBinary(b); err != nil || m != (Money{}) {
+		t.Errorf("Expected zero value got %+v, %v", m, err)
+	}
+}
+
+func TestMoney_UnmarshalBinaryErrors(t *testing.T) {
+	tcs := [][]byte{
+		nil,
+		{2, 3, 'U', 'S', 'D', 3, 4, 0x04, 0xe2},
+		{1, 9, 'U', 'S', 'D'},
+		{1, 3, 'U', 'S', 'D'},
+		{1, 3, 'U', 'S', 'D', 3, 4, 0x04},
+		{1, 3, 'U', 'S', 'D', 3, 4, 0x04, 0xe2, 0x00},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		if err := m.UnmarshalBinary(tc); !errors.Is(err, ErrInvalidBinaryUnmarshal) {
+			t.Errorf("Expected %v to fail with %v got %v", tc, ErrInvalidBinaryUnmarshal, err)
+		}
+	}
+}
+
+func TestMoney_Gob(t *testing.T) {
+	type entry struct {
+		Price *Money
+		Tax   Money
+	}
+	given := entry{Price: New("1234.5678", USD), Tax: *New("-0.125", KWD)}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(given); err != nil {
+		t.Error(err)
+	}
+
+	var r entry
+	if err := gob.NewDecoder(&buf).Decode(&r); err != nil {
+		t.Error(err)
+	}
+
+	if r.Price.String() != "1234.5678 USD" || r.Tax.String() != "-0.125 KWD" {
+		t.Errorf("Expected %+v got %+v", given, r)
+	}
+}
@@ -16,8 +16,8 @@ func (c *calculator) multiply(a Amount, m Amount) Amount {
 	return a.Mul(m)
 }
 
-func (c *calculator) divide(a Amount, d Amount, precision int32) Amount {
-	return a.Div(d).Truncate(precision)
+func (c *calculator) divide(a Amount, d Amount, precision int32, mode RoundingMode) Amount {
+	return mode.quo(a, d, precision)
 }
 
 func (c *calculator) modulus(a Amount, b Amount, precision int32) Amount {
@@ -30,7 +30,8 @@ func (c *calculator) allocate(a, r, s Amount, precision int32) Amount {
 		return decimal.NewFromInt(0)
 	}
 
-	return a.Mul(r).DivRound(s, precision)
+	q, _ := a.Mul(r).QuoRem(s, precision)
+	return q
 }
 
 func (c *calculator) absolute(a Amount) Amount {
@@ -42,7 +43,7 @@ func (c *calculator) negative(a Amount) Amount {
 	return a.Neg()
 }
 
-func (c *calculator) round(a Amount, e int32) Amount {
+func (c *calculator) round(a Amount, e int32, mode RoundingMode) Amount {
 
-	return a.Round(e)
+	return mode.Round(a, e)
 }
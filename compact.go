@@ -0,0 +1,100 @@
+package money
+
+import (
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"golang.org/x/text/language"
+)
+
+// compactSuffixes holds the suffixes of thousands, millions, billions and trillions by language.
+var compactSuffixes = map[string][]string{
+	"en": {"K", "M", "B", "T"},
+	"de": {"\u00a0Tsd.", "\u00a0Mio.", "\u00a0Mrd.", "\u00a0Bio."},
+	"es": {"\u00a0mil", "\u00a0M", "\u00a0mil\u00a0M", "\u00a0B"},
+	"fr": {"\u00a0k", "\u00a0M", "\u00a0Md", "\u00a0Bn"},
+	"nl": {"K", "\u00a0mln.", "\u00a0mld.", "\u00a0bln."},
+	"pt": {"\u00a0mil", "\u00a0mi", "\u00a0bi", "\u00a0tri"},
+}
+
+// CompactOptions configures DisplayCompact.
+type CompactOptions struct {
+	// SignificantDigits is the number of significant digits shown, 2 when unset.
+	// Digits of the integer part are never dropped, e.g. $123K with 2 significant digits.
+	SignificantDigits int
+	// Rounding is the rounding mode used to drop digits, the currency's rounding mode is used when unset.
+	Rounding RoundingMode
+	// Locale selects the separators, symbol and suffixes, the currency's formatting
+	// and English suffixes are used when unset.
+	Locale language.Tag
+}
+
+// DisplayCompact lets represent Money struct as a short string for dashboards, e.g. "$1.2K" or "€3.4M".
+// Amounts are never shown as "1000K", values rounding up to the next unit use that unit instead.
+func (m *Money) DisplayCompact(opts CompactOptions) string {
+	c := m.currency.get()
+
+	sig := opts.SignificantDigits
+	if sig <= 0 {
+		sig = 2
+	}
+	mode := opts.Rounding
+	if mode == 0 {
+		mode = c.roundingMode()
+	}
+
+	f, negative := c.Formatter(), ""
+	suffixes := compactSuffixes["en"]
+	if opts.Locale != language.Und {
+		lf := localeFormatter(opts.Locale)
+		f = lf.Formatter(c)
+		_, negative = lf.templates()
+		base, _ := opts.Locale.Base()
+		if s, ok := compactSuffixes[base.String()]; ok {
+			suffixes = s
+		}
+	}
+
+	thousand := decimal.NewFromInt(1000)
+	amount, unit := m.amount, -1
+	if amount.Abs().LessThan(thousand) {
+		amount = compactRound(amount, sig, c.Fraction, mode)
+	}
+	for amount.Abs().GreaterThanOrEqual(thousand) && unit < len(suffixes)-1 {
+		unit++
+		amount = compactRound(m.amount.Shift(-3*int32(unit+1)), sig, -1, mode)
+	}
+
+	f.Fraction = 0
+	if i := strings.Index(amount.String(), "."); i >= 0 {
+		f.Fraction = int32(len(amount.String()) - i - 1)
+	}
+	if negative != "" && amount.IsNegative() {
+		f.Template, amount = negative, amount.Abs()
+	}
+	if unit >= 0 {
+		f.Template = strings.Replace(f.Template, "1", "1"+suffixes[unit], 1)
+	}
+
+	return f.Format(amount)
+}
+
+// compactRound rounds the amount to the significant digits keeping all integer digits
+// and at most maxFraction fractional digits, negative maxFraction means no limit.
+// Trailing zeros are dropped.
+func compactRound(amount decimal.Decimal, sig int, maxFraction int32, mode RoundingMode) decimal.Decimal {
+	intDigits := len(amount.Abs().Truncate(0).String())
+	if amount.Abs().LessThan(decimal.NewFromInt(1)) {
+		intDigits = 1
+	}
+
+	places := int32(sig - intDigits)
+	if places < 0 {
+		places = 0
+	}
+	if maxFraction >= 0 && places > maxFraction {
+		places = maxFraction
+	}
+
+	return decimal.RequireFromString(mode.Round(amount, places).String())
+}
@@ -0,0 +1,49 @@
+package money
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestMoney_DisplayCompact(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		opts     CompactOptions
+		expected string
+	}{
+		{"1234", USD, CompactOptions{}, "$1.2K"},
+		{"3400000", EUR, CompactOptions{}, "€3.4M"},
+		{"12000000000", JPY, CompactOptions{}, "¥12B"},
+		{"-1234", USD, CompactOptions{}, "-$1.2K"},
+		{"12345", USD, CompactOptions{}, "$12K"},
+		{"123456", USD, CompactOptions{}, "$123K"},
+		{"1000", USD, CompactOptions{}, "$1K"},
+		{"1299", USD, CompactOptions{}, "$1.2K"},
+		{"1299", USD, CompactOptions{Rounding: RoundHalfUp}, "$1.3K"},
+		{"1234", USD, CompactOptions{SignificantDigits: 3}, "$1.23K"},
+		{"5.678", USD, CompactOptions{Rounding: RoundHalfUp}, "$5.7"},
+		{"5.678", JPY, CompactOptions{Rounding: RoundHalfUp}, "¥6"},
+		{"999", USD, CompactOptions{}, "$999"},
+		{"999.99", USD, CompactOptions{Rounding: RoundHalfUp}, "$1K"},
+		{"999999", USD, CompactOptions{}, "$999K"},
+		{"999999", USD, CompactOptions{Rounding: RoundHalfUp}, "$1M"},
+		{"-999999", USD, CompactOptions{Rounding: RoundHalfUp}, "-$1M"},
+		{"999950", USD, CompactOptions{SignificantDigits: 3, Rounding: RoundCeiling}, "$1M"},
+		{"1234000000000000", USD, CompactOptions{}, "$1,234T"},
+		{"1234", EUR, CompactOptions{Locale: language.German}, "1,2\u00a0Tsd. €"},
+		{"3400000", EUR, CompactOptions{Locale: language.French}, "3,4\u00a0M €"},
+		{"1234", USD, CompactOptions{Locale: language.MustParse("en-GB")}, "US$1.2K"},
+		{"1234", USD, CompactOptions{Locale: language.Japanese}, "$1.2K"},
+		{"-1234", EUR, CompactOptions{Locale: language.Dutch}, "€ -1,2K"},
+	}
+
+	for _, tc := range tcs {
+		r := New(tc.amount, tc.code).DisplayCompact(tc.opts)
+
+		if r != tc.expected {
+			t.Errorf("Expected %s %s to be %q got %q", tc.amount, tc.code, tc.expected, r)
+		}
+	}
+}
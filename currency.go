@@ -1,7 +1,11 @@
 package money
 
 import (
+	"errors"
+	"fmt"
 	"strings"
+
+	"github.com/shopspring/decimal"
 )
 
 // Currency represents money currency information required for formatting.
@@ -13,8 +17,19 @@ type Currency struct {
 	Decimal     string
 	Thousand    string
 	Fraction    int32
+	// Rounding is the rounding mode used for this currency, DefaultRoundingMode is used when unset.
+	Rounding RoundingMode
+	// CashIncrement is the smallest amount payable in cash, e.g. 0.05 for CHF.
+	// Zero means cash payments use the minor unit.
+	CashIncrement decimal.Decimal
+	// Grouping holds the digit group sizes from the decimal separator leftwards.
+	// Empty means groups of 3, INR uses "3;2" for lakh and crore.
+	Grouping Grouping
 }
 
+// ErrUnknownCurrency happens when a currency code is not in the currencies list.
+var ErrUnknownCurrency = errors.New("unknown currency")
+
 type Currencies map[string]*Currency
 
 // CurrencyByNumericCode returns the currency given the numeric code defined in ISO-4271.
@@ -53,7 +68,7 @@ var currencies = Currencies{
 	ANG: {Decimal: ",", Thousand: ".", Code: ANG, Fraction: 2, NumericCode: "532", Grapheme: "\u0192", Template: "$1"},
 	AOA: {Decimal: ".", Thousand: ",", Code: AOA, Fraction: 2, NumericCode: "973", Grapheme: "Kz", Template: "1$"},
 	ARS: {Decimal: ",", Thousand: ".", Code: ARS, Fraction: 2, NumericCode: "032", Grapheme: "$", Template: "$1"},
-	AUD: {Decimal: ".", Thousand: ",", Code: AUD, Fraction: 2, NumericCode: "036", Grapheme: "$", Template: "$1"},
+	AUD: {Decimal: ".", Thousand: ",", Code: AUD, Fraction: 2, NumericCode: "036", Grapheme: "$", Template: "$1", CashIncrement: decimal.New(5, -2)},
 	AWG: {Decimal: ".", Thousand: ",", Code: AWG, Fraction: 2, NumericCode: "533", Grapheme: "\u0192", Template: "1$"},
 	AZN: {Decimal: ".", Thousand: ",", Code: AZN, Fraction: 2, NumericCode: "944", Grapheme: "\u20bc", Template: "$1"},
 	BAM: {Decimal: ".", Thousand: ",", Code: BAM, Fraction: 2, NumericCode: "977", Grapheme: "KM", Template: "$1"},
@@ -72,9 +87,9 @@ var currencies = Currencies{
 	BYN: {Decimal: ",", Thousand: " ", Code: BYN, Fraction: 2, NumericCode: "933", Grapheme: "p.", Template: "1 $"},
 	BYR: {Decimal: ",", Thousand: " ", Code: BYR, Fraction: 0, NumericCode: "", Grapheme: "p.", Template: "1 $"},
 	BZD: {Decimal: ".", Thousand: ",", Code: BZD, Fraction: 2, NumericCode: "084", Grapheme: "BZ$", Template: "$1"},
-	CAD: {Decimal: ".", Thousand: ",", Code: CAD, Fraction: 2, NumericCode: "124", Grapheme: "$", Template: "$1"},
+	CAD: {Decimal: ".", Thousand: ",", Code: CAD, Fraction: 2, NumericCode: "124", Grapheme: "$", Template: "$1", CashIncrement: decimal.New(5, -2)},
 	CDF: {Decimal: ".", Thousand: ",", Code: CDF, Fraction: 2, NumericCode: "976", Grapheme: "FC", Template: "1$"},
-	CHF: {Decimal: ".", Thousand: ",", Code: CHF, Fraction: 2, NumericCode: "756", Grapheme: "CHF", Template: "1 $"},
+	CHF: {Decimal: ".", Thousand: ",", Code: CHF, Fraction: 2, NumericCode: "756", Grapheme: "CHF", Template: "1 $", CashIncrement: decimal.New(5, -2)},
 	CLF: {Decimal: ",", Thousand: ".", Code: CLF, Fraction: 4, NumericCode: "990", Grapheme: "UF", Template: "$1"},
 	CLP: {Decimal: ",", Thousand: ".", Code: CLP, Fraction: 0, NumericCode: "152", Grapheme: "$", Template: "$1"},
 	CNY: {Decimal: ".", Thousand: ",", Code: CNY, Fraction: 2, NumericCode: "156", Grapheme: "\u5143", Template: "1 $"},
@@ -83,9 +98,9 @@ var currencies = Currencies{
 	CUC: {Decimal: ".", Thousand: ",", Code: CUC, Fraction: 2, NumericCode: "931", Grapheme: "$", Template: "1$"},
 	CUP: {Decimal: ".", Thousand: ",", Code: CUP, Fraction: 2, NumericCode: "192", Grapheme: "$MN", Template: "$1"},
 	CVE: {Decimal: ".", Thousand: ",", Code: CVE, Fraction: 2, NumericCode: "132", Grapheme: "$", Template: "1$"},
-	CZK: {Decimal: ".", Thousand: ",", Code: CZK, Fraction: 2, NumericCode: "203", Grapheme: "K\u010d", Template: "1 $"},
+	CZK: {Decimal: ".", Thousand: ",", Code: CZK, Fraction: 2, NumericCode: "203", Grapheme: "K\u010d", Template: "1 $", CashIncrement: decimal.New(1, 0)},
 	DJF: {Decimal: ".", Thousand: ",", Code: DJF, Fraction: 0, NumericCode: "262", Grapheme: "Fdj", Template: "1 $"},
-	DKK: {Decimal: ",", Thousand: ".", Code: DKK, Fraction: 2, NumericCode: "208", Grapheme: "kr", Template: "$ 1"},
+	DKK: {Decimal: ",", Thousand: ".", Code: DKK, Fraction: 2, NumericCode: "208", Grapheme: "kr", Template: "$ 1", CashIncrement: decimal.New(50, -2)},
 	DOP: {Decimal: ".", Thousand: ",", Code: DOP, Fraction: 2, NumericCode: "214", Grapheme: "RD$", Template: "$1"},
 	DZD: {Decimal: ".", Thousand: ",", Code: DZD, Fraction: 2, NumericCode: "012", Grapheme: ".\u062f.\u062c", Template: "1 $"},
 	EEK: {Decimal: ".", Thousand: ",", Code: EEK, Fraction: 2, NumericCode: "", Grapheme: "kr", Template: "$1"},
@@ -113,7 +128,7 @@ var currencies = Currencies{
 	IDR: {Decimal: ",", Thousand: ".", Code: IDR, Fraction: 2, NumericCode: "360", Grapheme: "Rp", Template: "$1"},
 	ILS: {Decimal: ".", Thousand: ",", Code: ILS, Fraction: 2, NumericCode: "376", Grapheme: "\u20aa", Template: "$1"},
 	IMP: {Decimal: ".", Thousand: ",", Code: IMP, Fraction: 2, NumericCode: "", Grapheme: "\u00a3", Template: "$1"},
-	INR: {Decimal: ".", Thousand: ",", Code: INR, Fraction: 2, NumericCode: "356", Grapheme: "\u20b9", Template: "$1"},
+	INR: {Decimal: ".", Thousand: ",", Code: INR, Fraction: 2, NumericCode: "356", Grapheme: "\u20b9", Template: "$1", Grouping: "3;2"},
 	IQD: {Decimal: ".", Thousand: ",", Code: IQD, Fraction: 3, NumericCode: "368", Grapheme: ".\u062f.\u0639", Template: "1 $"},
 	IRR: {Decimal: ".", Thousand: ",", Code: IRR, Fraction: 2, NumericCode: "364", Grapheme: "\ufdfc", Template: "1 $"},
 	ISK: {Decimal: ",", Thousand: ".", Code: ISK, Fraction: 0, NumericCode: "352", Grapheme: "kr", Template: "$1"},
@@ -155,9 +170,9 @@ var currencies = Currencies{
 	NAD: {Decimal: ".", Thousand: ",", Code: NAD, Fraction: 2, NumericCode: "516", Grapheme: "$", Template: "$1"},
 	NGN: {Decimal: ".", Thousand: ",", Code: NGN, Fraction: 2, NumericCode: "566", Grapheme: "\u20a6", Template: "$1"},
 	NIO: {Decimal: ".", Thousand: ",", Code: NIO, Fraction: 2, NumericCode: "558", Grapheme: "C$", Template: "$1"},
-	NOK: {Decimal: ".", Thousand: ",", Code: NOK, Fraction: 2, NumericCode: "578", Grapheme: "kr", Template: "1 $"},
-	NPR: {Decimal: ".", Thousand: ",", Code: NPR, Fraction: 2, NumericCode: "524", Grapheme: "\u20a8", Template: "$1"},
-	NZD: {Decimal: ".", Thousand: ",", Code: NZD, Fraction: 2, NumericCode: "554", Grapheme: "$", Template: "$1"},
+	NOK: {Decimal: ".", Thousand: ",", Code: NOK, Fraction: 2, NumericCode: "578", Grapheme: "kr", Template: "1 $", CashIncrement: decimal.New(1, 0)},
+	NPR: {Decimal: ".", Thousand: ",", Code: NPR, Fraction: 2, NumericCode: "524", Grapheme: "\u20a8", Template: "$1", Grouping: "3;2"},
+	NZD: {Decimal: ".", Thousand: ",", Code: NZD, Fraction: 2, NumericCode: "554", Grapheme: "$", Template: "$1", CashIncrement: decimal.New(10, -2)},
 	OMR: {Decimal: ".", Thousand: ",", Code: OMR, Fraction: 3, NumericCode: "512", Grapheme: "\ufdfc", Template: "1 $"},
 	PAB: {Decimal: ".", Thousand: ",", Code: PAB, Fraction: 2, NumericCode: "590", Grapheme: "B/.", Template: "$1"},
 	PEN: {Decimal: ".", Thousand: ",", Code: PEN, Fraction: 2, NumericCode: "604", Grapheme: "S/", Template: "$1"},
@@ -176,7 +191,7 @@ var currencies = Currencies{
 	SBD: {Decimal: ".", Thousand: ",", Code: SBD, Fraction: 2, NumericCode: "090", Grapheme: "$", Template: "$1"},
 	SCR: {Decimal: ".", Thousand: ",", Code: SCR, Fraction: 2, NumericCode: "690", Grapheme: "\u20a8", Template: "$1"},
 	SDG: {Decimal: ".", Thousand: ",", Code: SDG, Fraction: 2, NumericCode: "938", Grapheme: "\u00a3", Template: "$1"},
-	SEK: {Decimal: ".", Thousand: ",", Code: SEK, Fraction: 2, NumericCode: "752", Grapheme: "kr", Template: "1 $"},
+	SEK: {Decimal: ".", Thousand: ",", Code: SEK, Fraction: 2, NumericCode: "752", Grapheme: "kr", Template: "1 $", CashIncrement: decimal.New(1, 0)},
 	SGD: {Decimal: ".", Thousand: ",", Code: SGD, Fraction: 2, NumericCode: "702", Grapheme: "$", Template: "$1"},
 	SHP: {Decimal: ".", Thousand: ",", Code: SHP, Fraction: 2, NumericCode: "654", Grapheme: "\u00a3", Template: "$1"},
 	SKK: {Decimal: ".", Thousand: ",", Code: SKK, Fraction: 2, NumericCode: "", Grapheme: "Sk", Template: "$1"},
@@ -247,26 +262,56 @@ func GetCurrency(code string) *Currency {
 	return currencies.CurrencyByCode(strings.ToUpper(code))
 }
 
+// MarshalText is implementation of encoding.TextMarshaler, it uses the currency code, e.g. "USD".
+func (c Currency) MarshalText() ([]byte, error) {
+	return []byte(c.Code), nil
+}
+
+// UnmarshalText is implementation of encoding.TextUnmarshaler.
+// It returns ErrUnknownCurrency if the currency isn't registered.
+func (c *Currency) UnmarshalText(text []byte) error {
+	rc := GetCurrency(string(text))
+	if rc == nil {
+		return fmt.Errorf("%w: %q", ErrUnknownCurrency, text)
+	}
+
+	*c = *rc
+	return nil
+}
+
 // Formatter returns currency formatter representing
 // used currency structure.
 func (c *Currency) Formatter() *Formatter {
-	return &Formatter{
+	f := &Formatter{
 		Fraction: c.Fraction,
 		Decimal:  c.Decimal,
 		Thousand: c.Thousand,
 		Grapheme: c.Grapheme,
 		Template: c.Template,
+		Grouping: c.Grouping,
+		Negative: DefaultDisplayOptions.Negative,
+		Sign:     DefaultDisplayOptions.Sign,
+		Rounding: c.roundingMode(),
+	}
+	if DefaultDisplayOptions.Rounding != 0 {
+		f.Rounding = DefaultDisplayOptions.Rounding
 	}
+
+	return f
 }
 
 // getDefault represent default currency if currency is not found in currencies list.
 // Grapheme and Code fields will be changed by currency code.
 func (c *Currency) getDefault() *Currency {
-	return &Currency{Decimal: ".", Thousand: ",", Code: c.Code, Fraction: 2, Grapheme: c.Grapheme, Template: "1$"}
+	return &Currency{Decimal: ".", Thousand: ",", Code: c.Code, Fraction: 2, Grapheme: c.Code, Template: "1$"}
 }
 
 // get extended currency using currencies list.
+// A nil Currency, as in the zero value of Money, gets the default currency with an empty code.
 func (c *Currency) get() *Currency {
+	if c == nil {
+		return (&Currency{}).getDefault()
+	}
 	if curr, ok := currencies[c.Code]; ok {
 		return curr
 	}
@@ -274,6 +319,41 @@ func (c *Currency) get() *Currency {
 	return c.getDefault()
 }
 
+// minorUnit returns the value of the smallest unit of the currency,
+// e.g. 0.01 for a currency with a fraction of 2.
+func (c *Currency) minorUnit() decimal.Decimal {
+	return decimal.New(1, -c.Fraction)
+}
+
+// roundingMode returns the rounding mode of the currency falling back to DefaultRoundingMode.
+func (c *Currency) roundingMode() RoundingMode {
+	if c != nil && c.Rounding != 0 {
+		return c.Rounding
+	}
+
+	return DefaultRoundingMode
+}
+
+// cashIncrement returns the cash-rounding increment of the currency falling back to its minor unit.
+func (c *Currency) cashIncrement() decimal.Decimal {
+	if !c.CashIncrement.IsPositive() {
+		return c.minorUnit()
+	}
+
+	return c.CashIncrement
+}
+
+// explicitRoundingMode returns the rounding mode of RoundToCurrency and RoundToCash.
+// Asking to round goes to the nearest unit, ties away from zero, unless the currency sets its own mode.
+// Unlike roundingMode it doesn't fall back to DefaultRoundingMode, which truncates by default.
+func (c *Currency) explicitRoundingMode() RoundingMode {
+	if c != nil && c.Rounding != 0 {
+		return c.Rounding
+	}
+
+	return RoundHalfUp
+}
+
 func (c *Currency) equals(oc *Currency) bool {
 	return c.Code == oc.Code
 }
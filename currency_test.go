@@ -1,6 +1,8 @@
 package money
 
 import (
+	"encoding/json"
+	"errors"
 	"reflect"
 	"testing"
 )
@@ -137,3 +139,67 @@ func TestCurrencies(t *testing.T) {
 		t.Errorf("unexpected currency returned. expected: %v, got %v", curBar, ac)
 	}
 }
+
+func TestCurrency_MarshalText(t *testing.T) {
+	b, err := GetCurrency(EUR).MarshalText()
+	if err != nil || string(b) != "EUR" {
+		t.Errorf("Expected %s got %s, %v", "EUR", string(b), err)
+	}
+
+	b, err = json.Marshal(map[*Currency]int{GetCurrency(USD): 1})
+	if err != nil || string(b) != `{"USD":1}` {
+		t.Errorf("Expected %s got %s, %v", `{"USD":1}`, string(b), err)
+	}
+}
+
+func TestCurrency_UnmarshalText(t *testing.T) {
+	tcs := []struct {
+		text     string
+		expected *Currency
+		err      error
+	}{
+		{"USD", GetCurrency(USD), nil},
+		{"jpy", GetCurrency(JPY), nil},
+		{"XYZ", nil, ErrUnknownCurrency},
+		{"", nil, ErrUnknownCurrency},
+	}
+
+	for _, tc := range tcs {
+		var c Currency
+		err := c.UnmarshalText([]byte(tc.text))
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %s to fail with %v got %v", tc.text, tc.err, err)
+		}
+
+		if tc.expected != nil && !tc.expected.equals(&c) {
+			t.Errorf("Expected %v got %v", tc.expected, c)
+		}
+	}
+
+	var r struct {
+		Currency Currency `json:"currency"`
+	}
+	if err := json.Unmarshal([]byte(`{"currency":"GBP"}`), &r); err != nil || r.Currency.Grapheme != "£" {
+		t.Errorf("Expected %s got %v, %v", GBP, r.Currency, err)
+	}
+}
+
+func TestCurrency_Comparable(t *testing.T) {
+	inr, usd := *GetCurrency(INR), *GetCurrency(USD)
+	seen := map[Currency]bool{inr: true}
+
+	if !seen[*GetCurrency(INR)] || seen[usd] || inr == usd {
+		t.Errorf("Expected currencies to compare by value")
+	}
+
+	b, err := json.Marshal(map[Currency]int{usd: 1})
+	if err != nil || string(b) != `{"USD":1}` {
+		t.Errorf("Expected %s got %s, %v", `{"USD":1}`, string(b), err)
+	}
+
+	var r map[Currency]int
+	if err := json.Unmarshal([]byte(`{"INR":2}`), &r); err != nil || r[inr] != 2 {
+		t.Errorf("Expected INR key got %v, %v", r, err)
+	}
+}
@@ -0,0 +1,239 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrRateNotFound happens when no exchange rate is known for a currency pair.
+	ErrRateNotFound = errors.New("exchange rate not found")
+
+	// ErrInvalidRate happens when an exchange rate is not positive or has no currencies.
+	ErrInvalidRate = errors.New("invalid exchange rate")
+)
+
+// ExchangeRate represents the price of one unit of the Base currency in the Quote currency
+// at a given time, e.g. Base EUR, Quote USD, Rate 1.08 means 1 EUR = 1.08 USD.
+type ExchangeRate struct {
+	Base      string
+	Quote     string
+	Rate      decimal.Decimal
+	Timestamp time.Time
+
+	// num and den hold derived rates exactly as num/den, so conversions through
+	// an inverse or cross rate are not affected by the precision of Rate.
+	num, den decimal.Decimal
+}
+
+// Converter converts Money into other currencies.
+type Converter interface {
+	Convert(m *Money, to string) (*Money, error)
+}
+
+// NewExchangeRate creates and returns new instance of ExchangeRate.
+// Rate can be of any type supported by ConvertToDecimal.
+func NewExchangeRate(base, quote string, rate any, timestamp time.Time) (ExchangeRate, error) {
+	dec, err := convertToDecimal(rate)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+
+	r := ExchangeRate{
+		Base:      strings.ToUpper(base),
+		Quote:     strings.ToUpper(quote),
+		Rate:      dec,
+		Timestamp: timestamp,
+	}
+	if err := r.validate(); err != nil {
+		return ExchangeRate{}, err
+	}
+
+	return r, nil
+}
+
+// Inverse returns the rate of the Quote currency in the Base currency.
+func (r ExchangeRate) Inverse() ExchangeRate {
+	num, den := r.fraction()
+
+	return ExchangeRate{
+		Base:      r.Quote,
+		Quote:     r.Base,
+		Rate:      den.Div(num),
+		Timestamp: r.Timestamp,
+		num:       den,
+		den:       num,
+	}
+}
+
+// Convert returns new Money struct with the value of given Money in the Quote currency,
+// rounded to the Quote currency fraction like RoundToCurrency.
+func (r ExchangeRate) Convert(m *Money) (*Money, error) {
+	if m.currency == nil || m.currency.Code != r.Base {
+		return nil, ErrCurrencyMismatch
+	}
+
+	to := newCurrency(r.Quote).get()
+	num, den := r.fraction()
+	amount := mutate.calc.divide(mutate.calc.multiply(m.amount, num), den, to.Fraction, to.explicitRoundingMode())
+
+	return &Money{amount: amount, currency: to}, nil
+}
+
+// fraction returns the exact rate as num/den.
+func (r ExchangeRate) fraction() (num, den decimal.Decimal) {
+	if r.den.IsZero() {
+		return r.Rate, decimal.NewFromInt(1)
+	}
+
+	return r.num, r.den
+}
+
+func (r ExchangeRate) validate() error {
+	if r.Base == "" || r.Quote == "" || !r.Rate.IsPositive() {
+		return fmt.Errorf("%w: %s/%s %s", ErrInvalidRate, r.Base, r.Quote, r.Rate)
+	}
+
+	return nil
+}
+
+// cross returns the rate from r.Base to o.Quote through r.Quote.
+func (r ExchangeRate) cross(o ExchangeRate) ExchangeRate {
+	ts := r.Timestamp
+	if o.Timestamp.Before(ts) {
+		ts = o.Timestamp
+	}
+
+	n1, d1 := r.fraction()
+	n2, d2 := o.fraction()
+	num, den := n1.Mul(n2), d1.Mul(d2)
+
+	return ExchangeRate{
+		Base:      r.Base,
+		Quote:     o.Quote,
+		Rate:      num.Div(den),
+		Timestamp: ts,
+		num:       num,
+		den:       den,
+	}
+}
+
+type currencyPair struct {
+	base, quote string
+}
+
+// RateTable is an in-memory Converter holding the latest ExchangeRate per currency pair.
+// Missing pairs are derived from the inverse rate or triangulated through the base currency.
+// It is safe for concurrent use.
+type RateTable struct {
+	base  string
+	mu    sync.RWMutex
+	rates map[currencyPair]ExchangeRate
+}
+
+// NewRateTable creates new RateTable which triangulates cross rates through the given base currency.
+func NewRateTable(base string) *RateTable {
+	return &RateTable{
+		base:  strings.ToUpper(base),
+		rates: make(map[currencyPair]ExchangeRate),
+	}
+}
+
+// Base returns the currency cross rates are triangulated through.
+func (t *RateTable) Base() string {
+	return t.base
+}
+
+// Set adds or replaces the given rates. A rate older than the one already stored
+// for the same pair is ignored, so loading historical data keeps the latest rates.
+func (t *RateTable) Set(rates ...ExchangeRate) error {
+	for _, r := range rates {
+		if err := r.validate(); err != nil {
+			return err
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, r := range rates {
+		pair := currencyPair{r.Base, r.Quote}
+		if old, ok := t.rates[pair]; ok && r.Timestamp.Before(old.Timestamp) {
+			continue
+		}
+		t.rates[pair] = r
+	}
+
+	return nil
+}
+
+// Rates returns all rates stored in the table.
+func (t *RateTable) Rates() []ExchangeRate {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	rates := make([]ExchangeRate, 0, len(t.rates))
+	for _, r := range t.rates {
+		rates = append(rates, r)
+	}
+
+	return rates
+}
+
+// Rate returns the rate from one currency to another.
+// It tries the direct rate, the inverse rate and finally the cross rate through the base currency.
+func (t *RateTable) Rate(from, to string) (ExchangeRate, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return ExchangeRate{Base: from, Quote: to, Rate: decimal.NewFromInt(1)}, nil
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if r, ok := t.lookup(from, to); ok {
+		return r, nil
+	}
+
+	if from != t.base && to != t.base {
+		r1, ok1 := t.lookup(from, t.base)
+		r2, ok2 := t.lookup(t.base, to)
+		if ok1 && ok2 {
+			return r1.cross(r2), nil
+		}
+	}
+
+	return ExchangeRate{}, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+}
+
+// lookup returns the direct or inverse rate of the pair.
+func (t *RateTable) lookup(from, to string) (ExchangeRate, bool) {
+	if r, ok := t.rates[currencyPair{from, to}]; ok {
+		return r, true
+	}
+
+	if r, ok := t.rates[currencyPair{to, from}]; ok {
+		return r.Inverse(), true
+	}
+
+	return ExchangeRate{}, false
+}
+
+// Convert implements Converter using the rates stored in the table.
+func (t *RateTable) Convert(m *Money, to string) (*Money, error) {
+	if m.currency == nil {
+		return nil, ErrCurrencyMismatch
+	}
+
+	r, err := t.Rate(m.currency.Code, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Convert(m)
+}
@@ -0,0 +1,216 @@
+package money
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// RateSetter stores exchange rates, it is implemented by RateTable and HistoricalRates.
+type RateSetter interface {
+	Set(rates ...ExchangeRate) error
+}
+
+// RateParseError reports a malformed row of an exchange rate file.
+type RateParseError struct {
+	Line int
+	Err  error
+}
+
+func (e *RateParseError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *RateParseError) Unwrap() error {
+	return e.Err
+}
+
+// rateDateLayout is the date format used by ECB and CSV rate files.
+const rateDateLayout = "2006-01-02"
+
+// ParseECBRates parses exchange rates in the ECB eurofxref XML format, both the daily
+// and the historical files. Every rate has EUR as its base currency.
+func ParseECBRates(r io.Reader) ([]ExchangeRate, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rates []ExchangeRate
+		day   time.Time
+	)
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	lines := &lineCounter{data: data, line: 1}
+	for {
+		line := lines.at(dec.InputOffset())
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, &RateParseError{Line: lines.at(dec.InputOffset()), Err: err}
+		}
+
+		se, ok := tok.(xml.StartElement)
+		if !ok || se.Name.Local != "Cube" {
+			continue
+		}
+
+		var at, code, rate string
+		for _, attr := range se.Attr {
+			switch attr.Name.Local {
+			case "time":
+				at = attr.Value
+			case "currency":
+				code = attr.Value
+			case "rate":
+				rate = attr.Value
+			}
+		}
+
+		if at != "" {
+			day, err = time.Parse(rateDateLayout, at)
+			if err != nil {
+				return nil, &RateParseError{Line: line, Err: err}
+			}
+		}
+		if code == "" && rate == "" {
+			continue
+		}
+		if day.IsZero() {
+			return nil, &RateParseError{Line: line, Err: errors.New("rate outside of a dated Cube")}
+		}
+
+		er, err := parseRate(EUR, code, rate, day)
+		if err != nil {
+			return nil, &RateParseError{Line: line, Err: err}
+		}
+		rates = append(rates, er)
+	}
+
+	return rates, nil
+}
+
+// ParseCSVRates parses exchange rates from CSV rows of "date,base,quote,rate",
+// e.g. "2024-05-10,EUR,USD,1.0783". A leading header row starting with "date" is skipped.
+func ParseCSVRates(r io.Reader) ([]ExchangeRate, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 4
+	cr.TrimLeadingSpace = true
+
+	var rates []ExchangeRate
+	for first := true; ; first = false {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			var pe *csv.ParseError
+			if errors.As(err, &pe) {
+				return nil, &RateParseError{Line: pe.Line, Err: pe.Err}
+			}
+			return nil, err
+		}
+
+		// FieldPos is only valid for a record read without error.
+		line, _ := cr.FieldPos(0)
+
+		if first && strings.EqualFold(record[0], "date") {
+			continue
+		}
+
+		day, err := time.Parse(rateDateLayout, record[0])
+		if err != nil {
+			return nil, &RateParseError{Line: line, Err: err}
+		}
+
+		er, err := parseRate(record[1], record[2], record[3], day)
+		if err != nil {
+			return nil, &RateParseError{Line: line, Err: err}
+		}
+		rates = append(rates, er)
+	}
+
+	return rates, nil
+}
+
+// LoadECBRates parses ECB eurofxref XML rates and stores them in dst.
+func LoadECBRates(dst RateSetter, r io.Reader) error {
+	rates, err := ParseECBRates(r)
+	if err != nil {
+		return err
+	}
+
+	return dst.Set(rates...)
+}
+
+// LoadCSVRates parses CSV rates and stores them in dst.
+func LoadCSVRates(dst RateSetter, r io.Reader) error {
+	rates, err := ParseCSVRates(r)
+	if err != nil {
+		return err
+	}
+
+	return dst.Set(rates...)
+}
+
+// LoadRatesFile parses the rate file at path and stores the rates in dst.
+// Files with the .xml extension are read as ECB eurofxref XML, other files as CSV.
+func LoadRatesFile(dst RateSetter, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".xml") {
+		err = LoadECBRates(dst, f)
+	} else {
+		err = LoadCSVRates(dst, f)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+// parseRate creates an ExchangeRate validating the currencies against the currencies list.
+func parseRate(base, quote, rate string, day time.Time) (ExchangeRate, error) {
+	for _, code := range []string{base, quote} {
+		if GetCurrency(code) == nil {
+			return ExchangeRate{}, fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+		}
+	}
+
+	return NewExchangeRate(base, quote, rate, day)
+}
+
+// lineCounter returns the 1-based line numbers of non-decreasing offsets in data,
+// counting only the newlines since the previous offset.
+type lineCounter struct {
+	data   []byte
+	offset int64
+	line   int
+}
+
+// at returns the line number of the offset.
+func (c *lineCounter) at(offset int64) int {
+	if offset > int64(len(c.data)) {
+		offset = int64(len(c.data))
+	}
+	if offset > c.offset {
+		c.line += bytes.Count(c.data[c.offset:offset], []byte("\n"))
+		c.offset = offset
+	}
+
+	return c.line
+}
@@ -0,0 +1,171 @@
+package money
+
+import (
+	"encoding/csv"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const ecbDaily = `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time='2024-05-10'>
+			<Cube currency='USD' rate='1.0783'/>
+			<Cube currency='JPY' rate='167.83'/>
+			<Cube currency='GBP' rate='0.86075'/>
+		</Cube>
+		<Cube time='2024-05-09'>
+			<Cube currency='USD' rate='1.0749'/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+
+func TestParseECBRates(t *testing.T) {
+	rates, err := ParseECBRates(strings.NewReader(ecbDaily))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rates) != 4 {
+		t.Fatalf("Expected %d rates got %d", 4, len(rates))
+	}
+
+	r := rates[2]
+	if r.Base != EUR || r.Quote != GBP || r.Rate.String() != "0.86075" || r.Timestamp.Format(rateDateLayout) != "2024-05-10" {
+		t.Errorf("Unexpected rate %+v", r)
+	}
+
+	if rates[3].Timestamp.Format(rateDateLayout) != "2024-05-09" {
+		t.Errorf("Expected %s got %s", "2024-05-09", rates[3].Timestamp)
+	}
+}
+
+func TestParseECBRates_Errors(t *testing.T) {
+	tcs := []struct {
+		replace string
+		with    string
+		line    int
+		err     error
+	}{
+		{"'JPY'", "'XYZ'", 10, ErrUnknownCurrency},
+		{"'1.0749'", "'abc'", 14, ErrInvalidAmount},
+		{"'0.86075'", "'-1'", 11, ErrInvalidRate},
+		{"'2024-05-09'", "'09/05/2024'", 13, nil},
+		{"</gesmes:Envelope>", "", 17, nil},
+	}
+
+	for _, tc := range tcs {
+		_, err := ParseECBRates(strings.NewReader(strings.Replace(ecbDaily, tc.replace, tc.with, 1)))
+
+		var pe *RateParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("Expected *RateParseError replacing %s got %v", tc.replace, err)
+			continue
+		}
+
+		if pe.Line != tc.line {
+			t.Errorf("Expected error on line %d replacing %s got %d", tc.line, tc.replace, pe.Line)
+		}
+
+		if tc.err != nil && !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v replacing %s got %v", tc.err, tc.replace, err)
+		}
+	}
+}
+
+func TestParseCSVRates(t *testing.T) {
+	given := "date,base,quote,rate\n2024-05-10,EUR,USD,1.0783\n2024-05-10, usd, jpy, 155.64\n"
+
+	rates, err := ParseCSVRates(strings.NewReader(given))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rates) != 2 {
+		t.Fatalf("Expected %d rates got %d", 2, len(rates))
+	}
+
+	if r := rates[1]; r.Base != USD || r.Quote != JPY || r.Rate.String() != "155.64" {
+		t.Errorf("Unexpected rate %+v", r)
+	}
+
+	tcs := []struct {
+		given string
+		line  int
+		err   error
+	}{
+		{"2024-05-10,EUR,USD,1.0783\n2024-05-10,EUR,ABC,1\n", 2, ErrUnknownCurrency},
+		{"date,base,quote,rate\n2024-05-10,EUR,USD\n", 2, nil},
+		{"2024-05-10,EUR,USD,1.0783\n\n10/05/2024,EUR,USD,1\n", 3, nil},
+		{"2024-05-10,EUR,USD,x\n", 1, ErrInvalidAmount},
+		{"a\"b,EUR,USD,1\n", 1, csv.ErrBareQuote},
+		{"2024-05-10,EUR,USD,1.0783\na\"b,EUR,USD,1\n", 2, csv.ErrBareQuote},
+	}
+
+	for _, tc := range tcs {
+		_, err := ParseCSVRates(strings.NewReader(tc.given))
+
+		var pe *RateParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("Expected *RateParseError for %q got %v", tc.given, err)
+			continue
+		}
+
+		if pe.Line != tc.line {
+			t.Errorf("Expected error on line %d for %q got %d", tc.line, tc.given, pe.Line)
+		}
+
+		if tc.err != nil && !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %q got %v", tc.err, tc.given, err)
+		}
+	}
+}
+
+func TestLoadRatesFile(t *testing.T) {
+	dir := t.TempDir()
+	xmlPath := filepath.Join(dir, "eurofxref.xml")
+	csvPath := filepath.Join(dir, "rates.csv")
+
+	if err := os.WriteFile(xmlPath, []byte(ecbDaily), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(csvPath, []byte("2024-05-10,USD,CHF,0.9065\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	table := NewRateTable(EUR)
+	for _, path := range []string{xmlPath, csvPath} {
+		if err := LoadRatesFile(table, path); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if r, _ := table.Rate(EUR, USD); r.Rate.String() != "1.0783" {
+		t.Errorf("Expected latest rate %s got %s", "1.0783", r.Rate)
+	}
+
+	r, err := table.Convert(New(100, CHF), USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Display() != "$110.31" {
+		t.Errorf("Expected %s got %s", "$110.31", r.Display())
+	}
+
+	history := NewHistoricalRates(EUR, FallbackNone, 0)
+	if err := LoadECBRates(history, strings.NewReader(ecbDaily)); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := history.ConvertAt(New(1, EUR), USD, date(2024, 5, 9)); err != nil {
+		t.Errorf("Expected no error got %v", err)
+	}
+}
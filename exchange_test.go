@@ -0,0 +1,183 @@
+package money
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestRateTable(t *testing.T) *RateTable {
+	ts := time.Date(2024, 5, 10, 16, 0, 0, 0, time.UTC)
+	table := NewRateTable(EUR)
+
+	for _, r := range []struct {
+		quote string
+		rate  string
+	}{
+		{USD, "1.0783"},
+		{GBP, "0.86075"},
+		{JPY, "167.83"},
+	} {
+		rate, err := NewExchangeRate(EUR, r.quote, r.rate, ts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := table.Set(rate); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return table
+}
+
+func TestNewExchangeRate(t *testing.T) {
+	r, err := NewExchangeRate("eur", "usd", 1.08, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Base != EUR || r.Quote != USD || r.Rate.String() != "1.08" {
+		t.Errorf("Unexpected rate %+v", r)
+	}
+
+	tcs := []struct {
+		base, quote string
+		rate        any
+		err         error
+	}{
+		{EUR, USD, 0, ErrInvalidRate},
+		{EUR, USD, "-1", ErrInvalidRate},
+		{"", USD, 1, ErrInvalidRate},
+		{EUR, USD, "abc", ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		if _, err := NewExchangeRate(tc.base, tc.quote, tc.rate, time.Time{}); !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v for %s/%s %v got %v", tc.err, tc.base, tc.quote, tc.rate, err)
+		}
+	}
+}
+
+func TestRateTable_Convert(t *testing.T) {
+	table := newTestRateTable(t)
+
+	tcs := []struct {
+		amount   string
+		from     string
+		to       string
+		expected string
+	}{
+		{"100", EUR, USD, "$107.83"},
+		{"100", USD, EUR, "€92.74"},
+		{"100", GBP, USD, "$125.27"},
+		{"1000", USD, JPY, "¥155,643"},
+		{"12.34", USD, USD, "$12.34"},
+		{"0.001", EUR, USD, "$0.00"},
+	}
+
+	for _, tc := range tcs {
+		r, err := table.Convert(New(tc.amount, tc.from), tc.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if r.Display() != tc.expected {
+			t.Errorf("Expected %s %s in %s to be %s got %s", tc.amount, tc.from, tc.to, tc.expected, r.Display())
+		}
+	}
+
+	if _, err := table.Convert(New(1, USD), CHF); !errors.Is(err, ErrRateNotFound) {
+		t.Errorf("Expected ErrRateNotFound got %v", err)
+	}
+}
+
+func TestRateTable_ConvertExact(t *testing.T) {
+	table := newTestRateTable(t)
+
+	r, _ := NewExchangeRate(EUR, CHF, 3, time.Time{})
+	if err := table.Set(r); err != nil {
+		t.Fatal(err)
+	}
+
+	tcs := []struct {
+		amount   string
+		from     string
+		to       string
+		expected string
+	}{
+		{"3", CHF, EUR, "€1.00"},
+		{"1", CHF, EUR, "€0.33"},
+		{"3", CHF, USD, "$1.08"},
+		{"1.0783", USD, CHF, "3.00 CHF"},
+		{"0.86075", GBP, USD, "$1.08"},
+	}
+
+	for _, tc := range tcs {
+		m, err := table.Convert(New(tc.amount, tc.from), tc.to)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if m.Display() != tc.expected {
+			t.Errorf("Expected %s %s in %s to be %s got %s", tc.amount, tc.from, tc.to, tc.expected, m.Display())
+		}
+	}
+}
+
+func TestRateTable_Rate(t *testing.T) {
+	table := newTestRateTable(t)
+
+	r, err := table.Rate(GBP, JPY)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Base != GBP || r.Quote != JPY {
+		t.Errorf("Expected %s/%s got %s/%s", GBP, JPY, r.Base, r.Quote)
+	}
+
+	if r.Rate.Round(4).String() != "194.9811" {
+		t.Errorf("Expected %s got %s", "194.9811", r.Rate.Round(4))
+	}
+
+	if len(table.Rates()) != 3 {
+		t.Errorf("Expected %d rates got %d", 3, len(table.Rates()))
+	}
+}
+
+func TestExchangeRate_Convert(t *testing.T) {
+	r, _ := NewExchangeRate(EUR, USD, "1.5", time.Time{})
+
+	if _, err := r.Convert(New(1, USD)); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("Expected ErrCurrencyMismatch got %v", err)
+	}
+
+	m, err := r.Convert(New("0.99", EUR))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Display() != "$1.49" {
+		t.Errorf("Expected %s got %s", "$1.49", m.Display())
+	}
+}
+
+func TestRateTable_Concurrent(t *testing.T) {
+	table := newTestRateTable(t)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			_, _ = table.Convert(New(1, USD), GBP)
+		}()
+		go func() {
+			defer wg.Done()
+			r, _ := NewExchangeRate(EUR, CHF, "0.97", time.Time{})
+			_ = table.Set(r)
+		}()
+	}
+	wg.Wait()
+}
@@ -1,12 +1,80 @@
 package money
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/shopspring/decimal"
-	"github.com/spf13/cast"
 )
 
+// NegativeStyle specifies how Formatter marks negative amounts.
+type NegativeStyle int
+
+const (
+	// NegativeMinusBeforeSymbol puts the minus in front of the formatted amount, e.g. "-£1.00" or "-1,00 €".
+	NegativeMinusBeforeSymbol NegativeStyle = iota
+	// NegativeMinusAfterSymbol puts the minus directly in front of the number, e.g. "£-1.00" or "-1,00 €".
+	NegativeMinusAfterSymbol
+	// NegativeTrailingMinus puts the minus after the formatted amount, e.g. "£1.00-".
+	NegativeTrailingMinus
+	// NegativeParentheses wraps the formatted amount in parentheses as in accounting, e.g. "(£1.00)".
+	NegativeParentheses
+)
+
+// SignStyle specifies whether Formatter marks positive amounts.
+type SignStyle int
+
+const (
+	// SignNegativeOnly only marks negative amounts.
+	SignNegativeOnly SignStyle = iota
+	// SignAlways also marks positive amounts with a plus, placed where the minus would go,
+	// e.g. "+£1.00". Zero amounts are never signed.
+	SignAlways
+)
+
+// DisplayOptions configures how Money is displayed.
+type DisplayOptions struct {
+	Negative NegativeStyle
+	Sign     SignStyle
+	// Rounding is the rounding mode used to fit the amount to the fraction,
+	// the currency's rounding mode is used when unset.
+	Rounding RoundingMode
+}
+
+// DefaultDisplayOptions is used by Display and by the Formatter of every Currency.
+var DefaultDisplayOptions = DisplayOptions{}
+
+// Grouping holds the digit group sizes from the decimal separator leftwards separated by ";",
+// the last size repeats and 0 leaves the remaining digits ungrouped, as POSIX LC_NUMERIC grouping.
+// E.g. "3;2" groups as 12,34,56,789. Empty means groups of 3.
+// It is a string so Currency and Formatter stay comparable.
+type Grouping string
+
+// sizes returns the group sizes, invalid sizes leave the remaining digits ungrouped.
+func (g Grouping) sizes() []int {
+	if g == "" {
+		return []int{3}
+	}
+
+	parts := strings.Split(string(g), ";")
+	sizes := make([]int, len(parts))
+	for i, p := range parts {
+		sizes[i], _ = strconv.Atoi(strings.TrimSpace(p))
+	}
+
+	return sizes
+}
+
+// groupingOf returns the Grouping of the group sizes.
+func groupingOf(sizes ...int) Grouping {
+	parts := make([]string, len(sizes))
+	for i, size := range sizes {
+		parts[i] = strconv.Itoa(size)
+	}
+
+	return Grouping(strings.Join(parts, ";"))
+}
+
 // Formatter stores Money formatting information.
 type Formatter struct {
 	Decimal  string
@@ -14,6 +82,13 @@ type Formatter struct {
 	Grapheme string
 	Template string
 	Fraction int32
+	// Grouping holds the digit group sizes, e.g. "3;2" groups as 12,34,56,789. Empty means groups of 3.
+	Grouping Grouping
+	Negative NegativeStyle
+	Sign     SignStyle
+	// Rounding is the rounding mode used to fit the amount to the fraction,
+	// DefaultRoundingMode is used when unset.
+	Rounding RoundingMode
 }
 
 // NewFormatter creates new Formatter instance.
@@ -27,43 +102,82 @@ func NewFormatter(fraction int32, decimal, thousand, grapheme, template string)
 	}
 }
 
-// Format returns string of formatted integer using given currency template.
+// Format returns string of formatted amount using given currency template.
+// The amount is rounded to the fraction using the Rounding mode and formatted exactly.
 func (f *Formatter) Format(amount decimal.Decimal) string {
+	mode := f.Rounding
+	if mode == 0 {
+		mode = DefaultRoundingMode
+	}
+	amount = mode.Round(amount, f.Fraction)
+
 	// Work with absolute amount value
-	sa := cast.ToString(amount.IntPart())
-	sa = strings.TrimPrefix(sa, "-")
+	sa := amount.Abs().StringFixed(f.Fraction)
+	dg := ""
+	if i := strings.Index(sa, "."); i >= 0 {
+		sa, dg = sa[:i], sa[i+1:]
+	}
 
 	if f.Thousand != "" {
-		for i := len(sa) - 3; i > 0; i -= 3 {
-			sa = sa[:i] + f.Thousand + sa[i:]
-		}
+		sa = f.group(sa)
 	}
 
 	if f.Fraction > 0 {
-		dg := cast.ToString(amount.Sub(decimal.NewFromInt(amount.IntPart())).InexactFloat64())
-		dg = dg[strings.Index(dg, ".")+1:]
-
-		if len(dg) > int(f.Fraction) {
-			dg = dg[:f.Fraction]
-		} else {
-			for i := len(dg); i < int(f.Fraction); i++ {
-				dg = dg + "0"
-			}
-		}
-
 		sa = sa + f.Decimal + dg
 	}
+
+	sign := ""
+	if amount.IsNegative() {
+		sign = "-"
+	} else if f.Sign == SignAlways && amount.IsPositive() {
+		sign = "+"
+	}
+
+	if f.Negative == NegativeMinusAfterSymbol {
+		sa = sign + sa
+	}
 	sa = strings.Replace(f.Template, "1", sa, 1)
 	sa = strings.Replace(sa, "$", f.Grapheme, 1)
 
-	// Add minus sign for negative amount.
-	if amount.IsNegative() {
-		sa = "-" + sa
+	// Add sign for signed amount.
+	switch {
+	case sign == "" || f.Negative == NegativeMinusAfterSymbol:
+	case f.Negative == NegativeTrailingMinus:
+		sa = sa + sign
+	case f.Negative == NegativeParentheses && sign == "-":
+		sa = "(" + sa + ")"
+	default:
+		sa = sign + sa
 	}
 
 	return sa
 }
 
+// group inserts the thousand separator into the integer digits following the Grouping sizes.
+func (f *Formatter) group(digits string) string {
+	sizes := f.Grouping.sizes()
+
+	var groups []string
+	for i := 0; ; i++ {
+		size := sizes[len(sizes)-1]
+		if i < len(sizes) {
+			size = sizes[i]
+		}
+		if size <= 0 || len(digits) <= size {
+			groups = append(groups, digits)
+			break
+		}
+		groups = append(groups, digits[len(digits)-size:])
+		digits = digits[:len(digits)-size]
+	}
+
+	for i, j := 0, len(groups)-1; i < j; i, j = i+1, j-1 {
+		groups[i], groups[j] = groups[j], groups[i]
+	}
+
+	return strings.Join(groups, f.Thousand)
+}
+
 // abs return absolute value of given integer.
 func (f Formatter) abs(amount int64) int64 {
 	if amount < 0 {
@@ -1,6 +1,7 @@
 package money
 
 import (
+	"math/rand"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -87,3 +88,125 @@ func TestFormatter_Format(t *testing.T) {
 		}
 	}
 }
+
+func TestFormatter_FormatSign(t *testing.T) {
+	tcs := []struct {
+		template string
+		negative NegativeStyle
+		sign     SignStyle
+		amount   float64
+		expected string
+	}{
+		{"$1", NegativeMinusBeforeSymbol, SignNegativeOnly, -1234, "-£1,234.00"},
+		{"$1", NegativeMinusBeforeSymbol, SignNegativeOnly, 1234, "£1,234.00"},
+		{"$1", NegativeMinusBeforeSymbol, SignAlways, 1234, "+£1,234.00"},
+		{"$1", NegativeMinusBeforeSymbol, SignAlways, 0, "£0.00"},
+		{"$1", NegativeMinusAfterSymbol, SignNegativeOnly, -1234, "£-1,234.00"},
+		{"$1", NegativeMinusAfterSymbol, SignAlways, 1234, "£+1,234.00"},
+		{"1 $", NegativeMinusAfterSymbol, SignNegativeOnly, -1234, "-1,234.00 £"},
+		{"$1", NegativeTrailingMinus, SignNegativeOnly, -1234, "£1,234.00-"},
+		{"$1", NegativeTrailingMinus, SignAlways, 1234, "£1,234.00+"},
+		{"$1", NegativeParentheses, SignNegativeOnly, -1234, "(£1,234.00)"},
+		{"$1", NegativeParentheses, SignNegativeOnly, 1234, "£1,234.00"},
+		{"$1", NegativeParentheses, SignAlways, 1234, "+£1,234.00"},
+		{"1 $", NegativeParentheses, SignNegativeOnly, -0.5, "(0.50 £)"},
+	}
+
+	for _, tc := range tcs {
+		formatter := NewFormatter(2, ".", ",", "£", tc.template)
+		formatter.Negative = tc.negative
+		formatter.Sign = tc.sign
+		r := formatter.Format(decimal.NewFromFloat(tc.amount))
+
+		if r != tc.expected {
+			t.Errorf("Expected %f formatted to be %s got %s", tc.amount, tc.expected, r)
+		}
+	}
+}
+
+func TestFormatter_FormatExact(t *testing.T) {
+	tcs := []struct {
+		fraction int32
+		rounding RoundingMode
+		amount   string
+		expected string
+	}{
+		{2, 0, "123456789012345678901234.56", "123,456,789,012,345,678,901,234.56 $"},
+		{2, 0, "0.29", "0.29 $"},
+		{2, 0, "1e21", "1,000,000,000,000,000,000,000.00 $"},
+		{2, 0, "1.23e-7", "0.00 $"},
+		{2, 0, "-0.001", "0.00 $"},
+		{8, 0, "0.12345678", "0.12345678 $"},
+		{2, RoundTruncate, "2.345", "2.34 $"},
+		{2, RoundHalfUp, "2.345", "2.35 $"},
+		{2, RoundHalfEven, "2.345", "2.34 $"},
+		{2, RoundHalfUp, "-2.345", "-2.35 $"},
+		{0, RoundHalfUp, "1234.5", "1,235 $"},
+		{2, RoundHalfUp, "999.995", "1,000.00 $"},
+	}
+
+	for _, tc := range tcs {
+		formatter := NewFormatter(tc.fraction, ".", ",", "$", "1 $")
+		formatter.Rounding = tc.rounding
+		r := formatter.Format(decimal.RequireFromString(tc.amount))
+
+		if r != tc.expected {
+			t.Errorf("Expected %s formatted to be %s got %s", tc.amount, tc.expected, r)
+		}
+	}
+}
+
+func TestFormatter_FormatParseRoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	amounts := []string{"0", "0.01", "-0.01", "1", "-1", "999.999", "1234567.891", "-98765432109876543210.987"}
+	for i := 0; i < 50; i++ {
+		amounts = append(amounts, decimal.New(rnd.Int63()-rnd.Int63(), -rnd.Int31n(6)).String())
+	}
+
+	for code, c := range currencies {
+		for _, a := range amounts {
+			m := New(a, code)
+			s := m.Display()
+
+			r, err := Parse(s, ParseOptions{Currency: code})
+			if err != nil {
+				t.Errorf("Expected %q (%s %s) to parse got %v", s, a, code, err)
+				continue
+			}
+
+			expected := c.roundingMode().Round(m.amount, c.Fraction)
+			if r.currency.Code != code || !r.amount.Equal(expected) {
+				t.Errorf("Expected %q to parse to %s %s got %s %s", s, expected, code, r.amount, r.currency.Code)
+			}
+		}
+	}
+}
+
+func TestFormatter_FormatGrouping(t *testing.T) {
+	tcs := []struct {
+		grouping Grouping
+		amount   string
+		expected string
+	}{
+		{"", "123456789", "123,456,789"},
+		{"3", "1234", "1,234"},
+		{"3;2", "123", "123"},
+		{"3;2", "1234", "1,234"},
+		{"3;2", "123456789", "12,34,56,789"},
+		{"3; 2", "123456789", "12,34,56,789"},
+		{"3;2", "-1234567", "-12,34,567"},
+		{"4", "123456789", "1,2345,6789"},
+		{"3;0", "123456789", "123456,789"},
+		{"3;x", "123456789", "123456,789"},
+	}
+
+	for _, tc := range tcs {
+		formatter := NewFormatter(0, ".", ",", "", "1")
+		formatter.Grouping = tc.grouping
+		r := formatter.Format(decimal.RequireFromString(tc.amount))
+
+		if r != tc.expected {
+			t.Errorf("Expected %s grouped by %v to be %s got %s", tc.amount, tc.grouping, tc.expected, r)
+		}
+	}
+}
@@ -6,9 +6,7 @@ require (
 	github.com/samber/lo v1.46.0
 	github.com/shopspring/decimal v1.4.0
 	github.com/spf13/cast v1.6.0
+	golang.org/x/text v0.16.0
 )
 
-require (
-	github.com/google/go-cmp v0.6.0 // indirect
-	golang.org/x/text v0.16.0 // indirect
-)
+require github.com/google/go-cmp v0.6.0 // indirect
@@ -0,0 +1,78 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrInvalidNanos happens when the nanos of a GoogleMoney are out of range or their sign doesn't match the units.
+var ErrInvalidNanos = errors.New("invalid nanos")
+
+// GoogleMoney has the fields of google.type.Money, so Money can be converted to and from
+// the protobuf message without depending on protobuf.
+type GoogleMoney struct {
+	// CurrencyCode is the ISO 4217 currency code.
+	CurrencyCode string
+	// Units is the whole units of the amount.
+	Units int64
+	// Nanos is the number of nano units of the amount, between -999,999,999 and +999,999,999.
+	// It must be zero or have the sign of Units when Units is not zero.
+	Nanos int32
+}
+
+// NewFromGoogleMoney creates and returns new instance of Money from a GoogleMoney.
+// It returns ErrUnknownCurrency if the currency isn't registered and ErrInvalidNanos
+// if the nanos are out of range or their sign doesn't match the units.
+func NewFromGoogleMoney(gm GoogleMoney) (*Money, error) {
+	c := GetCurrency(gm.CurrencyCode)
+	if c == nil {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownCurrency, gm.CurrencyCode)
+	}
+
+	if gm.Nanos <= -1e9 || gm.Nanos >= 1e9 {
+		return nil, fmt.Errorf("%w: %d is out of range", ErrInvalidNanos, gm.Nanos)
+	}
+	if (gm.Units > 0 && gm.Nanos < 0) || (gm.Units < 0 && gm.Nanos > 0) {
+		return nil, fmt.Errorf("%w: %d doesn't have the sign of units %d", ErrInvalidNanos, gm.Nanos, gm.Units)
+	}
+
+	// Drop the trailing zeros of nanos to keep the amount as precise as given, e.g. 12.5 rather than 12.500000000.
+	nanos, exp := int64(gm.Nanos), int32(-9)
+	for exp < 0 && nanos%10 == 0 {
+		nanos, exp = nanos/10, exp+1
+	}
+
+	return &Money{
+		amount:   decimal.New(gm.Units, 0).Add(decimal.New(nanos, exp)),
+		currency: c,
+	}, nil
+}
+
+// GoogleMoney converts Money to a GoogleMoney. Amounts with more than 9 fractional digits
+// are rounded using the rounding mode, or fail with ErrPrecisionLoss when the mode is unset.
+// It returns ErrMissingCurrency for Money without a currency and ErrOverflow if the units don't fit into an int64.
+func (m *Money) GoogleMoney(mode RoundingMode) (GoogleMoney, error) {
+	if m.currency == nil || m.currency.Code == "" {
+		return GoogleMoney{}, fmt.Errorf("%w: google.type.Money needs a currency code", ErrMissingCurrency)
+	}
+
+	amount := m.amount
+	if mode != 0 {
+		amount = mode.Round(amount, 9)
+	} else if !amount.Shift(9).IsInteger() {
+		return GoogleMoney{}, fmt.Errorf("%w: %s has more than 9 decimal places", ErrPrecisionLoss, m.AmountString())
+	}
+
+	units := amount.Truncate(0)
+	if !units.BigInt().IsInt64() {
+		return GoogleMoney{}, ErrOverflow
+	}
+
+	return GoogleMoney{
+		CurrencyCode: m.currency.Code,
+		Units:        units.IntPart(),
+		Nanos:        int32(amount.Sub(units).Shift(9).IntPart()),
+	}, nil
+}
@@ -0,0 +1,91 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestNewFromGoogleMoney(t *testing.T) {
+	tcs := []struct {
+		given    GoogleMoney
+		expected string
+		err      error
+	}{
+		{GoogleMoney{USD, 12, 500000000}, "12.50 USD", nil},
+		{GoogleMoney{"usd", -1, -750000000}, "-1.75 USD", nil},
+		{GoogleMoney{USD, 0, -500000000}, "-0.50 USD", nil},
+		{GoogleMoney{KWD, 1, 1}, "1.000000001 KWD", nil},
+		{GoogleMoney{JPY, 9223372036854775807, 0}, "9223372036854775807 JPY", nil},
+		{GoogleMoney{USD, 1, -500000000}, "", ErrInvalidNanos},
+		{GoogleMoney{USD, -1, 500000000}, "", ErrInvalidNanos},
+		{GoogleMoney{USD, 0, 1000000000}, "", ErrInvalidNanos},
+		{GoogleMoney{USD, 0, -1000000000}, "", ErrInvalidNanos},
+		{GoogleMoney{"XYZ", 1, 0}, "", ErrUnknownCurrency},
+	}
+
+	for _, tc := range tcs {
+		m, err := NewFromGoogleMoney(tc.given)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %+v to fail with %v got %v", tc.given, tc.err, err)
+		}
+
+		if tc.err == nil && m.String() != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, m.String())
+		}
+	}
+}
+
+func TestMoney_GoogleMoney(t *testing.T) {
+	tcs := []struct {
+		money    *Money
+		mode     RoundingMode
+		expected GoogleMoney
+		err      error
+	}{
+		{New("12.5", USD), 0, GoogleMoney{USD, 12, 500000000}, nil},
+		{New("-1.75", USD), 0, GoogleMoney{USD, -1, -750000000}, nil},
+		{New("-0.5", USD), 0, GoogleMoney{USD, 0, -500000000}, nil},
+		{New("0.123456789", EUR), 0, GoogleMoney{EUR, 0, 123456789}, nil},
+		{New("0.1234567895", EUR), 0, GoogleMoney{}, ErrPrecisionLoss},
+		{New("0.1234567895", EUR), RoundHalfUp, GoogleMoney{EUR, 0, 123456790}, nil},
+		{New("-0.1234567895", EUR), RoundTruncate, GoogleMoney{EUR, 0, -123456789}, nil},
+		{New("0.9999999999", EUR), RoundHalfUp, GoogleMoney{EUR, 1, 0}, nil},
+		{New("9223372036854775808", JPY), 0, GoogleMoney{}, ErrOverflow},
+		{&Money{}, 0, GoogleMoney{}, ErrMissingCurrency},
+		{&Money{amount: decimal.NewFromInt(0)}, 0, GoogleMoney{}, ErrMissingCurrency},
+		{New(1, ""), 0, GoogleMoney{}, ErrMissingCurrency},
+	}
+
+	for _, tc := range tcs {
+		r, err := tc.money.GoogleMoney(tc.mode)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %s to fail with %v got %v", tc.money, tc.err, err)
+		}
+
+		if r != tc.expected {
+			t.Errorf("Expected %+v got %+v", tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_GoogleMoneyRoundTrip(t *testing.T) {
+	for _, amount := range []string{"0", "12.34", "-12.34", "-0.000000001", "1234567890.987654321"} {
+		gm, err := New(amount, EUR).GoogleMoney(0)
+		if err != nil {
+			t.Error(err)
+		}
+
+		m, err := NewFromGoogleMoney(gm)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if ok, _ := m.Equals(New(amount, EUR)); !ok {
+			t.Errorf("Expected %s got %s", amount, m.String())
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrStaleRate happens when the closest known exchange rate is older than the allowed staleness.
+var ErrStaleRate = errors.New("exchange rate is stale")
+
+// FallbackPolicy specifies which rate HistoricalRates uses when there is no rate on the requested date.
+type FallbackPolicy int
+
+const (
+	// FallbackPrevious uses the latest rate before the date, e.g. the previous business day
+	// for dates falling on weekends or holidays.
+	FallbackPrevious FallbackPolicy = iota + 1
+	// FallbackNearest uses the rate closest to the date, before or after it.
+	// Ties go to the earlier rate.
+	FallbackNearest
+	// FallbackNone returns ErrRateNotFound unless there is a rate on the date.
+	FallbackNone
+)
+
+// HistoricalConverter converts Money into other currencies at the rate applying on a given date.
+type HistoricalConverter interface {
+	ConvertAt(m *Money, to string, at time.Time) (*Money, error)
+}
+
+// HistoricalRates is an in-memory store of exchange rates keyed by currency pair and date.
+// Missing pairs are derived from the inverse rate or triangulated through the base currency.
+// It implements Converter using the rates applying today. It is safe for concurrent use.
+type HistoricalRates struct {
+	base         string
+	policy       FallbackPolicy
+	maxStaleness time.Duration
+	now          func() time.Time
+
+	mu    sync.RWMutex
+	rates map[currencyPair][]ExchangeRate
+}
+
+// NewHistoricalRates creates new HistoricalRates which triangulates cross rates through the
+// given base currency and looks up missing dates using the policy. Rates older or newer than
+// maxStaleness compared to the requested date are rejected with ErrStaleRate, zero means no limit.
+func NewHistoricalRates(base string, policy FallbackPolicy, maxStaleness time.Duration) *HistoricalRates {
+	return &HistoricalRates{
+		base:         strings.ToUpper(base),
+		policy:       policy,
+		maxStaleness: maxStaleness,
+		now:          time.Now,
+		rates:        make(map[currencyPair][]ExchangeRate),
+	}
+}
+
+// Set adds the given rates replacing any rate of the same pair on the same date.
+// Only the date of the rate Timestamp is used, in UTC.
+func (h *HistoricalRates) Set(rates ...ExchangeRate) error {
+	for _, r := range rates {
+		if err := r.validate(); err != nil {
+			return err
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, r := range rates {
+		r.Timestamp = truncateToDate(r.Timestamp)
+		pair := currencyPair{r.Base, r.Quote}
+		series := h.rates[pair]
+
+		i := sort.Search(len(series), func(i int) bool {
+			return !series[i].Timestamp.Before(r.Timestamp)
+		})
+		if i < len(series) && series[i].Timestamp.Equal(r.Timestamp) {
+			series[i] = r
+			continue
+		}
+
+		series = append(series, ExchangeRate{})
+		copy(series[i+1:], series[i:])
+		series[i] = r
+		h.rates[pair] = series
+	}
+
+	return nil
+}
+
+// RateAt returns the rate from one currency to another applying on the given date.
+func (h *HistoricalRates) RateAt(from, to string, at time.Time) (ExchangeRate, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	at = truncateToDate(at)
+	if from == to {
+		return ExchangeRate{Base: from, Quote: to, Rate: decimal.NewFromInt(1), Timestamp: at}, nil
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	r, err := h.lookupAt(from, to, at)
+	if !errors.Is(err, ErrRateNotFound) || from == h.base || to == h.base {
+		return r, err
+	}
+
+	r1, err := h.lookupAt(from, h.base, at)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+	r2, err := h.lookupAt(h.base, to, at)
+	if err != nil {
+		return ExchangeRate{}, err
+	}
+
+	return r1.cross(r2), nil
+}
+
+// lookupAt returns the direct or inverse rate of the pair on the date.
+func (h *HistoricalRates) lookupAt(from, to string, at time.Time) (ExchangeRate, error) {
+	if series, ok := h.rates[currencyPair{from, to}]; ok {
+		return h.find(series, at)
+	}
+
+	if series, ok := h.rates[currencyPair{to, from}]; ok {
+		r, err := h.find(series, at)
+		if err != nil {
+			return ExchangeRate{}, err
+		}
+		return r.Inverse(), nil
+	}
+
+	return ExchangeRate{}, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+}
+
+// find returns the rate of the series applying on the date according to the fallback policy.
+func (h *HistoricalRates) find(series []ExchangeRate, at time.Time) (ExchangeRate, error) {
+	// i is the index of the first rate after the date.
+	i := sort.Search(len(series), func(i int) bool {
+		return series[i].Timestamp.After(at)
+	})
+
+	var r *ExchangeRate
+	switch h.policy {
+	case FallbackNearest:
+		if i > 0 {
+			r = &series[i-1]
+		}
+		if i < len(series) && (r == nil || series[i].Timestamp.Sub(at) < at.Sub(r.Timestamp)) {
+			r = &series[i]
+		}
+	case FallbackNone:
+		if i > 0 && series[i-1].Timestamp.Equal(at) {
+			r = &series[i-1]
+		}
+	default:
+		if i > 0 {
+			r = &series[i-1]
+		}
+	}
+
+	if r == nil {
+		return ExchangeRate{}, fmt.Errorf("%w: %s/%s on %s", ErrRateNotFound, series[0].Base, series[0].Quote, at.Format("2006-01-02"))
+	}
+
+	if h.maxStaleness > 0 {
+		age := at.Sub(r.Timestamp)
+		if age < 0 {
+			age = -age
+		}
+		if age > h.maxStaleness {
+			return ExchangeRate{}, fmt.Errorf("%w: %s/%s on %s is from %s", ErrStaleRate, r.Base, r.Quote, at.Format("2006-01-02"), r.Timestamp.Format("2006-01-02"))
+		}
+	}
+
+	return *r, nil
+}
+
+// ConvertAt implements HistoricalConverter using the rates stored for the given date.
+func (h *HistoricalRates) ConvertAt(m *Money, to string, at time.Time) (*Money, error) {
+	if m.currency == nil {
+		return nil, ErrCurrencyMismatch
+	}
+
+	r, err := h.RateAt(m.currency.Code, to, at)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Convert(m)
+}
+
+// Convert implements Converter using the rates applying today.
+func (h *HistoricalRates) Convert(m *Money, to string) (*Money, error) {
+	return h.ConvertAt(m, to, h.now())
+}
+
+// truncateToDate returns midnight UTC of the date of t.
+func truncateToDate(t time.Time) time.Time {
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,119 @@
+package money
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newTestHistoricalRates(t *testing.T, policy FallbackPolicy, maxStaleness time.Duration) *HistoricalRates {
+	h := NewHistoricalRates(EUR, policy, maxStaleness)
+
+	for _, r := range []struct {
+		quote string
+		rate  string
+		at    time.Time
+	}{
+		// Friday and the following Tuesday, Monday is a holiday.
+		{USD, "1.10", date(2024, 5, 10)},
+		{USD, "1.20", date(2024, 5, 14)},
+		{GBP, "0.80", date(2024, 5, 10)},
+		{GBP, "0.90", date(2024, 5, 14)},
+	} {
+		rate, err := NewExchangeRate(EUR, r.quote, r.rate, r.at.Add(16*time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := h.Set(rate); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return h
+}
+
+func TestHistoricalRates_ConvertAt(t *testing.T) {
+	tcs := []struct {
+		policy   FallbackPolicy
+		at       time.Time
+		from     string
+		to       string
+		expected string
+		err      error
+	}{
+		{FallbackPrevious, date(2024, 5, 10), EUR, USD, "$110.00", nil},
+		{FallbackPrevious, date(2024, 5, 13), EUR, USD, "$110.00", nil},
+		{FallbackPrevious, date(2024, 5, 14).Add(9 * time.Hour), EUR, USD, "$120.00", nil},
+		{FallbackPrevious, date(2024, 6, 1), EUR, USD, "$120.00", nil},
+		{FallbackPrevious, date(2024, 5, 9), EUR, USD, "", ErrRateNotFound},
+		{FallbackPrevious, date(2024, 5, 13), USD, EUR, "€90.91", nil},
+		{FallbackPrevious, date(2024, 5, 14), GBP, USD, "$133.33", nil},
+		{FallbackNearest, date(2024, 5, 9), EUR, USD, "$110.00", nil},
+		{FallbackNearest, date(2024, 5, 13), EUR, USD, "$120.00", nil},
+		{FallbackNearest, date(2024, 5, 12), EUR, USD, "$110.00", nil},
+		{FallbackNone, date(2024, 5, 13), EUR, USD, "", ErrRateNotFound},
+		{FallbackNone, date(2024, 5, 14), EUR, GBP, "£90.00", nil},
+		{FallbackPrevious, date(2024, 5, 14), EUR, CHF, "", ErrRateNotFound},
+	}
+
+	for _, tc := range tcs {
+		h := newTestHistoricalRates(t, tc.policy, 0)
+		r, err := h.ConvertAt(New(100, tc.from), tc.to, tc.at)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v converting %s to %s on %s got %v", tc.err, tc.from, tc.to, tc.at, err)
+			continue
+		}
+
+		if err == nil && r.Display() != tc.expected {
+			t.Errorf("Expected %s converting %s to %s on %s got %s", tc.expected, tc.from, tc.to, tc.at, r.Display())
+		}
+	}
+}
+
+func TestHistoricalRates_MaxStaleness(t *testing.T) {
+	h := newTestHistoricalRates(t, FallbackPrevious, 72*time.Hour)
+
+	if _, err := h.ConvertAt(New(1, EUR), USD, date(2024, 5, 13)); err != nil {
+		t.Errorf("Expected no error got %v", err)
+	}
+
+	if _, err := h.ConvertAt(New(1, EUR), USD, date(2024, 5, 20)); !errors.Is(err, ErrStaleRate) {
+		t.Errorf("Expected ErrStaleRate got %v", err)
+	}
+}
+
+func TestHistoricalRates_Convert(t *testing.T) {
+	var c Converter = newTestHistoricalRates(t, FallbackPrevious, 0)
+	c.(*HistoricalRates).now = func() time.Time { return date(2024, 5, 11) }
+
+	r, err := c.Convert(New(10, EUR), USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Display() != "$11.00" {
+		t.Errorf("Expected %s got %s", "$11.00", r.Display())
+	}
+}
+
+func TestHistoricalRates_SetReplaces(t *testing.T) {
+	h := newTestHistoricalRates(t, FallbackNone, 0)
+	r, _ := NewExchangeRate(EUR, USD, "1.15", date(2024, 5, 10))
+	if err := h.Set(r); err != nil {
+		t.Fatal(err)
+	}
+
+	rate, err := h.RateAt(EUR, USD, date(2024, 5, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rate.Rate.String() != "1.15" {
+		t.Errorf("Expected %s got %s", "1.15", rate.Rate)
+	}
+}
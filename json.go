@@ -0,0 +1,435 @@
+package money
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/shopspring/decimal"
+)
+
+// Names of the built-in JSON codecs.
+const (
+	// JSONMajorNumber encodes the amount in major units as a number, e.g. {"amount": 12.50, "currency": "USD"}.
+	// The amount is truncated to the currency fraction, use JSONMajorString for exact amounts.
+	JSONMajorNumber = "major-number"
+	// JSONMajorString encodes the amount in major units as a string, e.g. {"amount":"12.50","currency":"USD"}.
+	JSONMajorString = "major-string"
+	// JSONMinorUnits encodes the amount in minor units as an integer, e.g. {"amount":1250,"currency":"USD"}.
+	// Amounts more precise than the currency fraction fail with ErrPrecisionLoss.
+	JSONMinorUnits = "minor-units"
+	// JSONString encodes Money as a single string, e.g. "USD 12.50".
+	JSONString = "string"
+	// JSONGoMoney encodes Money like github.com/Rhymond/go-money, e.g. {"amount": 1250, "currency": "USD"}.
+	// Unlike JSONMinorUnits it truncates fractional minor units when decoding.
+	JSONGoMoney = "go-money"
+)
+
+// Names of the strict variants of the built-in JSON codecs. They decode like the codec they
+// are named after but reject unknown fields and unknown or missing currency codes.
+const (
+	JSONMajorNumberStrict = JSONMajorNumber + "-strict"
+	JSONMajorStringStrict = JSONMajorString + "-strict"
+	JSONMinorUnitsStrict  = JSONMinorUnits + "-strict"
+	JSONStringStrict      = JSONString + "-strict"
+	JSONGoMoneyStrict     = JSONGoMoney + "-strict"
+)
+
+// ErrUnknownJSONCodec happens when no JSONCodec is registered under a name.
+var ErrUnknownJSONCodec = errors.New("unknown json codec")
+
+// JSONCodec encodes and decodes Money as JSON.
+type JSONCodec interface {
+	MarshalMoney(m Money) ([]byte, error)
+	UnmarshalMoney(m *Money, b []byte) error
+}
+
+// jsonCodecFuncs adapts a pair of functions to JSONCodec.
+type jsonCodecFuncs struct {
+	marshal   func(m Money) ([]byte, error)
+	unmarshal func(m *Money, b []byte) error
+}
+
+// NewJSONCodec creates a JSONCodec from functions shaped like MarshalJSON and UnmarshalJSON.
+func NewJSONCodec(marshal func(m Money) ([]byte, error), unmarshal func(m *Money, b []byte) error) JSONCodec {
+	return jsonCodecFuncs{marshal: marshal, unmarshal: unmarshal}
+}
+
+func (c jsonCodecFuncs) MarshalMoney(m Money) ([]byte, error) {
+	return c.marshal(m)
+}
+
+func (c jsonCodecFuncs) UnmarshalMoney(m *Money, b []byte) error {
+	return c.unmarshal(m, b)
+}
+
+// builtinJSONCodec is a built-in codec, strict ones reject unknown fields and currencies when decoding.
+type builtinJSONCodec struct {
+	marshal   func(m Money) ([]byte, error)
+	unmarshal func(m *Money, b []byte, strict bool) error
+	strict    bool
+}
+
+func (c builtinJSONCodec) MarshalMoney(m Money) ([]byte, error) {
+	return c.marshal(m)
+}
+
+func (c builtinJSONCodec) UnmarshalMoney(m *Money, b []byte) error {
+	return c.unmarshal(m, b, c.strict)
+}
+
+var (
+	jsonCodecsMu     sync.RWMutex
+	jsonCodecs       = builtinJSONCodecs()
+	defaultJSONCodec = JSONMajorNumber
+)
+
+// builtinJSONCodecs returns the built-in codecs and their strict variants.
+func builtinJSONCodecs() map[string]JSONCodec {
+	codecs := make(map[string]JSONCodec)
+	for name, c := range map[string]builtinJSONCodec{
+		JSONMajorNumber: {marshal: marshalMajorNumberJSON, unmarshal: unmarshalObjectJSON},
+		JSONMajorString: {marshal: LosslessMarshalJSON, unmarshal: unmarshalObjectJSON},
+		JSONMinorUnits:  {marshal: marshalMinorUnitsJSON, unmarshal: unmarshalMinorUnitsJSON},
+		JSONString:      {marshal: marshalStringJSON, unmarshal: unmarshalStringJSON},
+		JSONGoMoney:     {marshal: marshalGoMoneyJSON, unmarshal: unmarshalGoMoneyJSON},
+	} {
+		codecs[name] = c
+		c.strict = true
+		codecs[name+"-strict"] = c
+	}
+
+	return codecs
+}
+
+// RegisterJSONCodec registers the JSONCodec under the name, replacing the one registered before.
+func RegisterJSONCodec(name string, c JSONCodec) {
+	jsonCodecsMu.Lock()
+	defer jsonCodecsMu.Unlock()
+
+	jsonCodecs[name] = c
+}
+
+// GetJSONCodec returns the JSONCodec registered under the name.
+func GetJSONCodec(name string) (JSONCodec, error) {
+	jsonCodecsMu.RLock()
+	defer jsonCodecsMu.RUnlock()
+
+	c, ok := jsonCodecs[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownJSONCodec, name)
+	}
+
+	return c, nil
+}
+
+// SetDefaultJSONCodec selects the codec used by json.Marshal and json.Unmarshal for Money, JSONMajorNumber by default.
+func SetDefaultJSONCodec(name string) error {
+	if _, err := GetJSONCodec(name); err != nil {
+		return err
+	}
+
+	jsonCodecsMu.Lock()
+	defer jsonCodecsMu.Unlock()
+
+	defaultJSONCodec = name
+	return nil
+}
+
+// defaultCodec returns the codec selected by SetDefaultJSONCodec.
+func defaultCodec() JSONCodec {
+	jsonCodecsMu.RLock()
+	defer jsonCodecsMu.RUnlock()
+
+	return jsonCodecs[defaultJSONCodec]
+}
+
+// MarshalJSONWith encodes Money using the codec registered under the name.
+func MarshalJSONWith(name string, m *Money) ([]byte, error) {
+	c, err := GetJSONCodec(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.MarshalMoney(*m)
+}
+
+// UnmarshalJSONWith decodes Money using the codec registered under the name.
+func UnmarshalJSONWith(name string, m *Money, b []byte) error {
+	c, err := GetJSONCodec(name)
+	if err != nil {
+		return err
+	}
+
+	return c.UnmarshalMoney(m, b)
+}
+
+// JSONShape names the codec used by JSONAs.
+type JSONShape interface {
+	JSONCodec() string
+}
+
+// Shapes of the built-in JSON codecs for JSONAs.
+type (
+	MajorNumberShape struct{}
+	MajorStringShape struct{}
+	MinorUnitsShape  struct{}
+	StringShape      struct{}
+	GoMoneyShape     struct{}
+)
+
+func (MajorNumberShape) JSONCodec() string { return JSONMajorNumber }
+func (MajorStringShape) JSONCodec() string { return JSONMajorString }
+func (MinorUnitsShape) JSONCodec() string  { return JSONMinorUnits }
+func (StringShape) JSONCodec() string      { return JSONString }
+func (GoMoneyShape) JSONCodec() string     { return JSONGoMoney }
+
+// JSONAs wraps Money to encode it with the codec of the shape regardless of the default codec,
+// e.g. a struct field of type money.JSONAs[money.MinorUnitsShape]. A nil Money encodes as null.
+type JSONAs[S JSONShape] struct {
+	*Money
+}
+
+// MarshalJSON is implementation of json.Marshaller
+func (j JSONAs[S]) MarshalJSON() ([]byte, error) {
+	if j.Money == nil {
+		return []byte("null"), nil
+	}
+
+	var s S
+	return MarshalJSONWith(s.JSONCodec(), j.Money)
+}
+
+// UnmarshalJSON is implementation of json.Unmarshaller
+func (j *JSONAs[S]) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		j.Money = nil
+		return nil
+	}
+
+	var s S
+	m := &Money{}
+	if err := UnmarshalJSONWith(s.JSONCodec(), m, b); err != nil {
+		return err
+	}
+
+	j.Money = m
+	return nil
+}
+
+func defaultUnmarshalJSON(m *Money, b []byte) error {
+	return defaultCodec().UnmarshalMoney(m, b)
+}
+
+func defaultMarshalJSON(m Money) ([]byte, error) {
+	return defaultCodec().MarshalMoney(m)
+}
+
+// jsonMoney is the JSON object shape of Money.
+type jsonMoney struct {
+	Amount   json.RawMessage `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+// decodeJSONObject decodes the JSON object shape of Money, rejecting unknown fields and currencies if strict.
+func decodeJSONObject(b []byte, strict bool) (decimal.Decimal, string, error) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	if strict {
+		dec.DisallowUnknownFields()
+	}
+
+	var data jsonMoney
+	if err := dec.Decode(&data); err != nil {
+		return decimal.Zero, "", fmt.Errorf("%w: %v", ErrInvalidJSONUnmarshal, err)
+	}
+
+	amount, err := unmarshalJSONAmount(data.Amount)
+	if err != nil {
+		return decimal.Zero, "", err
+	}
+
+	if err := checkJSONCurrency(data.Currency, strict); err != nil {
+		return decimal.Zero, "", err
+	}
+
+	return amount, data.Currency, nil
+}
+
+// checkJSONCurrency rejects unknown currency codes if strict.
+func checkJSONCurrency(code string, strict bool) error {
+	if strict && GetCurrency(code) == nil {
+		return fmt.Errorf("%w: %v %q", ErrInvalidJSONUnmarshal, ErrUnknownCurrency, code)
+	}
+
+	return nil
+}
+
+// unmarshalJSONAmount decodes a JSON string or number amount without going through float64.
+func unmarshalJSONAmount(raw json.RawMessage) (decimal.Decimal, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return decimal.Zero, nil
+	}
+
+	s := string(raw)
+	if raw[0] == '"' {
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return decimal.Zero, fmt.Errorf("%w: %v", ErrInvalidJSONUnmarshal, err)
+		}
+	}
+
+	amount, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("%w: invalid amount %s", ErrInvalidJSONUnmarshal, raw)
+	}
+
+	return amount, nil
+}
+
+// setJSONMoney sets m to the decoded Money, or to the zero value when there is neither amount nor currency.
+func setJSONMoney(m *Money, amount decimal.Decimal, code string) {
+	if amount.IsZero() && code == "" {
+		*m = Money{}
+		return
+	}
+
+	*m = *New(amount, code)
+}
+
+func unmarshalObjectJSON(m *Money, b []byte, strict bool) error {
+	amount, code, err := decodeJSONObject(b, strict)
+	if err != nil {
+		return err
+	}
+
+	setJSONMoney(m, amount, code)
+	return nil
+}
+
+func marshalMajorNumberJSON(m Money) ([]byte, error) {
+	if m.currency == nil {
+		m.currency = newCurrency("").get()
+	}
+
+	buff := bytes.NewBufferString(fmt.Sprintf(`{"amount": %s, "currency": "%s"}`, m.amount.Truncate(m.currency.Fraction).StringFixed(m.currency.Fraction), m.Currency().Code))
+	return buff.Bytes(), nil
+}
+
+// LosslessMarshalJSON encodes Money with the exact amount as a string, e.g. {"amount":"1234.56","currency":"USD"}.
+// It is the marshal function of the JSONMajorString codec.
+func LosslessMarshalJSON(m Money) ([]byte, error) {
+	if m.currency == nil {
+		m.currency = newCurrency("").get()
+	}
+
+	return json.Marshal(struct {
+		Amount   string `json:"amount"`
+		Currency string `json:"currency"`
+	}{m.AmountString(), m.currency.Code})
+}
+
+func marshalMinorUnitsJSON(m Money) ([]byte, error) {
+	if m.currency == nil {
+		m.currency = newCurrency("").get()
+	}
+
+	units, err := m.MinorUnitsBig()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(struct {
+		Amount   json.Number `json:"amount"`
+		Currency string      `json:"currency"`
+	}{json.Number(units.String()), m.currency.Code})
+}
+
+func unmarshalMinorUnitsJSON(m *Money, b []byte, strict bool) error {
+	units, code, err := decodeJSONObject(b, strict)
+	if err != nil {
+		return err
+	}
+	if !units.IsInteger() {
+		return fmt.Errorf("%w: minor units %s are not an integer", ErrInvalidJSONUnmarshal, units)
+	}
+
+	setJSONMoney(m, units.Shift(-newCurrency(code).get().Fraction), code)
+	return nil
+}
+
+func marshalGoMoneyJSON(m Money) ([]byte, error) {
+	if m.currency == nil {
+		m.currency = newCurrency("").get()
+	}
+
+	units, err := m.MinorUnitsBig()
+	if err != nil {
+		return nil, err
+	}
+
+	buff := bytes.NewBufferString(fmt.Sprintf(`{"amount": %s, "currency": "%s"}`, units, m.currency.Code))
+	return buff.Bytes(), nil
+}
+
+func unmarshalGoMoneyJSON(m *Money, b []byte, strict bool) error {
+	units, code, err := decodeJSONObject(b, strict)
+	if err != nil {
+		return err
+	}
+
+	setJSONMoney(m, units.Truncate(0).Shift(-newCurrency(code).get().Fraction), code)
+	return nil
+}
+
+func marshalStringJSON(m Money) ([]byte, error) {
+	if m.currency == nil || m.currency.Code == "" {
+		return []byte(`""`), nil
+	}
+
+	return json.Marshal(m.currency.Code + " " + m.AmountString())
+}
+
+func unmarshalStringJSON(m *Money, b []byte, strict bool) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidJSONUnmarshal, err)
+	}
+
+	if s == "" {
+		*m = Money{}
+		return nil
+	}
+
+	amount, code, err := parseCodeAmount(s)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidJSONUnmarshal, err)
+	}
+	if err := checkJSONCurrency(code, strict); err != nil {
+		return err
+	}
+
+	*m = *New(amount, code)
+	return nil
+}
+
+// parseCodeAmount parses the code and the amount of Money written as "USD 12.50" or "12.50 USD".
+func parseCodeAmount(s string) (decimal.Decimal, string, error) {
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return decimal.Zero, "", fmt.Errorf("%w: %q is not a code and an amount", ErrInvalidAmount, s)
+	}
+
+	code, number := fields[0], fields[1]
+	if _, err := decimal.NewFromString(code); err == nil {
+		code, number = number, code
+	}
+
+	amount, err := decimal.NewFromString(number)
+	if err != nil {
+		return decimal.Zero, "", fmt.Errorf("%w: %q", ErrInvalidAmount, number)
+	}
+
+	return amount, code, nil
+}
@@ -0,0 +1,329 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestLosslessMarshal(t *testing.T) {
+	MarshalJSON = LosslessMarshalJSON
+	defer func() { MarshalJSON = defaultMarshalJSON }()
+
+	tcs := []struct {
+		money    *Money
+		expected string
+	}{
+		{New("1234.56", USD), `{"amount":"1234.56","currency":"USD"}`},
+		{New("123.45", IQD), `{"amount":"123.450","currency":"IQD"}`},
+		{New("12345678901234567890.12", USD), `{"amount":"12345678901234567890.12","currency":"USD"}`},
+		{New("0.125", USD), `{"amount":"0.125","currency":"USD"}`},
+		{&Money{}, `{"amount":"0.00","currency":""}`},
+	}
+
+	for _, tc := range tcs {
+		b, err := json.Marshal(tc.money)
+		if err != nil {
+			t.Error(err)
+		}
+
+		if string(b) != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, string(b))
+		}
+	}
+}
+
+func TestDefaultUnmarshalLossless(t *testing.T) {
+	tcs := []struct {
+		given    string
+		expected string
+	}{
+		{`{"amount": "1234.56", "currency": "USD"}`, "1234.56 USD"},
+		{`{"amount": 12345678901234567890.12, "currency": "USD"}`, "12345678901234567890.12 USD"},
+		{`{"amount": "12345678901234567890.12", "currency": "USD"}`, "12345678901234567890.12 USD"},
+		{`{"amount": 0.1, "currency": "USD"}`, "0.10 USD"},
+		{`{"amount": null, "currency": "USD"}`, "0.00 USD"},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		if err := defaultUnmarshalJSON(&m, []byte(tc.given)); err != nil {
+			t.Error(err)
+		}
+
+		if m.String() != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, m.String())
+		}
+	}
+
+	var s struct {
+		Price *Money `json:"price"`
+	}
+	if err := json.Unmarshal([]byte(`{"price": {"amount": 9007199254740993, "currency": "JPY"}}`), &s); err != nil {
+		t.Error(err)
+	}
+	if s.Price.String() != "9007199254740993 JPY" {
+		t.Errorf("Expected %s got %s", "9007199254740993 JPY", s.Price.String())
+	}
+}
+
+func TestUnmarshalStrict(t *testing.T) {
+	tcs := []struct {
+		given string
+		valid bool
+	}{
+		{`{"amount": "12.50", "currency": "USD"}`, true},
+		{`{"amount": "12.50", "currency": "XYZ"}`, false},
+		{`{"amount": "12.50"}`, false},
+		{`{}`, false},
+		{`{"amount": "12.50", "currency": "USD", "fraction": 2}`, false},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		err := UnmarshalJSONWith(JSONMajorNumberStrict, &m, []byte(tc.given))
+
+		if tc.valid && err != nil {
+			t.Errorf("Expected %s to be valid got %v", tc.given, err)
+		}
+
+		if !tc.valid && !errors.Is(err, ErrInvalidJSONUnmarshal) {
+			t.Errorf("Expected %s to fail with ErrInvalidJSONUnmarshal got %v", tc.given, err)
+		}
+
+		if err := defaultUnmarshalJSON(&m, []byte(tc.given)); err != nil {
+			t.Errorf("Expected %s to be valid for the default codec got %v", tc.given, err)
+		}
+	}
+}
+
+func TestJSONCodecs(t *testing.T) {
+	tcs := []struct {
+		codec    string
+		money    *Money
+		expected string
+	}{
+		{JSONMajorNumber, New("12.5", USD), `{"amount": 12.50, "currency": "USD"}`},
+		{JSONMajorString, New("12.5", USD), `{"amount":"12.50","currency":"USD"}`},
+		{JSONMinorUnits, New("12.5", USD), `{"amount":1250,"currency":"USD"}`},
+		{JSONMinorUnits, New("12345678901234567890.12", USD), `{"amount":1234567890123456789012,"currency":"USD"}`},
+		{JSONMinorUnits, New("-1.5", KWD), `{"amount":-1500,"currency":"KWD"}`},
+		{JSONString, New("12.5", USD), `"USD 12.50"`},
+		{JSONString, New("-0.125", USD), `"USD -0.125"`},
+		{JSONGoMoney, New("12.5", USD), `{"amount": 1250, "currency": "USD"}`},
+		{JSONGoMoney, New(1234, JPY), `{"amount": 1234, "currency": "JPY"}`},
+	}
+
+	for _, tc := range tcs {
+		b, err := MarshalJSONWith(tc.codec, tc.money)
+		if err != nil {
+			t.Errorf("Expected no error got %v", err)
+		}
+
+		if string(b) != tc.expected {
+			t.Errorf("Expected %s to encode %s as %s got %s", tc.codec, tc.money, tc.expected, string(b))
+		}
+
+		var m Money
+		if err := UnmarshalJSONWith(tc.codec, &m, b); err != nil {
+			t.Errorf("Expected no error got %v", err)
+		}
+
+		if ok, _ := m.Equals(tc.money); !ok {
+			t.Errorf("Expected %s to decode %s as %s got %s", tc.codec, string(b), tc.money, &m)
+		}
+	}
+}
+
+func TestJSONCodecs_WithoutCurrency(t *testing.T) {
+	tcs := []struct {
+		codec    string
+		expected string
+	}{
+		{JSONMajorNumber, `{"amount": 0.00, "currency": ""}`},
+		{JSONMajorString, `{"amount":"0.00","currency":""}`},
+		{JSONMinorUnits, `{"amount":0,"currency":""}`},
+		{JSONString, `""`},
+		{JSONGoMoney, `{"amount": 0, "currency": ""}`},
+	}
+
+	for _, tc := range tcs {
+		for _, m := range []*Money{{}, {amount: decimal.NewFromInt(0)}, Zero} {
+			b, err := MarshalJSONWith(tc.codec, m)
+			if err != nil {
+				t.Error(err)
+			}
+
+			if string(b) != tc.expected {
+				t.Errorf("Expected %s to encode %+v as %s got %s", tc.codec, m, tc.expected, string(b))
+			}
+
+			if err := UnmarshalJSONWith(tc.codec, &Money{}, b); err != nil {
+				t.Errorf("Expected %s to decode %s got %v", tc.codec, string(b), err)
+			}
+		}
+	}
+}
+
+func TestJSONCodecs_Unmarshal(t *testing.T) {
+	tcs := []struct {
+		codec    string
+		given    string
+		expected string
+	}{
+		{JSONString, `"12.50 USD"`, "12.50 USD"},
+		{JSONString, `""`, "<zero>"},
+		{JSONMinorUnits, `{"amount":"1250","currency":"USD"}`, "12.50 USD"},
+		{JSONMinorUnits, `{}`, "<zero>"},
+		{JSONGoMoney, `{"amount": 1250.7, "currency": "USD"}`, "12.50 USD"},
+		{JSONGoMoney, `{"amount": 0, "currency": ""}`, "<zero>"},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		if err := UnmarshalJSONWith(tc.codec, &m, []byte(tc.given)); err != nil {
+			t.Errorf("Expected no error got %v", err)
+		}
+
+		r := "<zero>"
+		if m != (Money{}) {
+			r = m.String()
+		}
+
+		if r != tc.expected {
+			t.Errorf("Expected %s to decode %s as %s got %s", tc.codec, tc.given, tc.expected, r)
+		}
+	}
+}
+
+func TestJSONCodecs_Errors(t *testing.T) {
+	if _, err := MarshalJSONWith(JSONMinorUnits, New("1.005", USD)); !errors.Is(err, ErrPrecisionLoss) {
+		t.Errorf("Expected %v got %v", ErrPrecisionLoss, err)
+	}
+
+	var m Money
+	tcs := []struct {
+		codec string
+		given string
+	}{
+		{JSONMinorUnits, `{"amount":12.5,"currency":"USD"}`},
+		{JSONString, `"USD"`},
+		{JSONString, `"USD twelve"`},
+		{JSONString, `12.5`},
+	}
+
+	for _, tc := range tcs {
+		if err := UnmarshalJSONWith(tc.codec, &m, []byte(tc.given)); !errors.Is(err, ErrInvalidJSONUnmarshal) {
+			t.Errorf("Expected %s decoding %s to fail with %v got %v", tc.codec, tc.given, ErrInvalidJSONUnmarshal, err)
+		}
+	}
+
+	if err := UnmarshalJSONWith(JSONStringStrict, &m, []byte(`"XYZ 12.50"`)); !errors.Is(err, ErrInvalidJSONUnmarshal) {
+		t.Errorf("Expected %v got %v", ErrInvalidJSONUnmarshal, err)
+	}
+
+	if _, err := GetJSONCodec("yaml"); !errors.Is(err, ErrUnknownJSONCodec) {
+		t.Errorf("Expected %v got %v", ErrUnknownJSONCodec, err)
+	}
+
+	if err := SetDefaultJSONCodec("yaml"); !errors.Is(err, ErrUnknownJSONCodec) {
+		t.Errorf("Expected %v got %v", ErrUnknownJSONCodec, err)
+	}
+}
+
+func TestJSONAs(t *testing.T) {
+	type invoice struct {
+		Total    JSONAs[MinorUnitsShape]  `json:"total"`
+		Tax      JSONAs[StringShape]      `json:"tax"`
+		Discount JSONAs[MajorStringShape] `json:"discount"`
+		Price    *Money                   `json:"price"`
+	}
+
+	given := invoice{
+		Total: JSONAs[MinorUnitsShape]{New("12.5", USD)},
+		Tax:   JSONAs[StringShape]{New("2.5", USD)},
+		Price: New("10", USD),
+	}
+	expected := `{"total":{"amount":1250,"currency":"USD"},"tax":"USD 2.50","discount":null,"price":{"amount":10.00,"currency":"USD"}}`
+
+	b, err := json.Marshal(given)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+
+	var r invoice
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Error(err)
+	}
+
+	if r.Total.String() != "12.50 USD" || r.Tax.String() != "2.50 USD" || r.Discount.Money != nil || r.Price.String() != "10.00 USD" {
+		t.Errorf("Expected %s to decode to %+v got %+v", expected, given, r)
+	}
+}
+
+type strictMinorUnitsShape struct{}
+
+func (strictMinorUnitsShape) JSONCodec() string { return JSONMinorUnitsStrict }
+
+func TestJSONAs_Strict(t *testing.T) {
+	var s struct {
+		Total JSONAs[strictMinorUnitsShape] `json:"total"`
+		Price *Money                        `json:"price"`
+	}
+
+	if err := json.Unmarshal([]byte(`{"total":{"amount":1250,"currency":"USD"}}`), &s); err != nil {
+		t.Error(err)
+	}
+
+	if s.Total.String() != "12.50 USD" {
+		t.Errorf("Expected %s got %s", "12.50 USD", s.Total.String())
+	}
+
+	if err := json.Unmarshal([]byte(`{"total":{"amount":1250,"currency":"XYZ"}}`), &s); !errors.Is(err, ErrInvalidJSONUnmarshal) {
+		t.Errorf("Expected %v got %v", ErrInvalidJSONUnmarshal, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"price":{"amount":12.5,"currency":"XYZ"}}`), &s); err != nil {
+		t.Errorf("Expected the default codec to accept unknown currencies got %v", err)
+	}
+}
+
+func TestSetDefaultJSONCodec(t *testing.T) {
+	if err := SetDefaultJSONCodec(JSONString); err != nil {
+		t.Error(err)
+	}
+	defer func() { _ = SetDefaultJSONCodec(JSONMajorNumber) }()
+
+	b, _ := json.Marshal(New("12.5", EUR))
+	if string(b) != `"EUR 12.50"` {
+		t.Errorf("Expected %s got %s", `"EUR 12.50"`, string(b))
+	}
+
+	var m Money
+	if err := json.Unmarshal([]byte(`"EUR 1.25"`), &m); err != nil || m.String() != "1.25 EUR" {
+		t.Errorf("Expected %s got %s, %v", "1.25 EUR", &m, err)
+	}
+}
+
+func TestRegisterJSONCodec(t *testing.T) {
+	RegisterJSONCodec("code-only", NewJSONCodec(
+		func(m Money) ([]byte, error) { return json.Marshal(m.Currency().Code) },
+		func(m *Money, b []byte) error { *m = *New(0, string(b[1:len(b)-1])); return nil },
+	))
+	defer func() {
+		jsonCodecsMu.Lock()
+		delete(jsonCodecs, "code-only")
+		jsonCodecsMu.Unlock()
+	}()
+
+	b, err := MarshalJSONWith("code-only", New(1, GBP))
+	if err != nil || string(b) != `"GBP"` {
+		t.Errorf("Expected %s got %s, %v", `"GBP"`, string(b), err)
+	}
+}
@@ -0,0 +1,343 @@
+// Package ledger implements a double-entry ledger on top of money.Money.
+//
+// Every Transaction is made of Postings debiting or crediting Accounts and is only
+// committed when its debits equal its credits in every currency. Committed transactions
+// are never changed, mistakes are corrected by committing a reversal entry.
+package ledger
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	money "github.com/rezaalavi/gmoney"
+)
+
+var (
+	// ErrUnbalanced happens when the debits of a transaction don't equal its credits in a currency.
+	ErrUnbalanced = errors.New("transaction is unbalanced")
+
+	// ErrInvalidPosting happens when a posting has no account, an invalid side or a non-positive amount.
+	ErrInvalidPosting = errors.New("invalid posting")
+
+	// ErrInvalidTransaction happens when a transaction has no ID or fewer than two postings.
+	ErrInvalidTransaction = errors.New("invalid transaction")
+
+	// ErrAccountNotFound happens when an account is not opened in the ledger.
+	ErrAccountNotFound = errors.New("account not found")
+
+	// ErrAccountExists happens when an account with the same ID is already opened.
+	ErrAccountExists = errors.New("account already exists")
+
+	// ErrTransactionNotFound happens when a transaction is not committed to the ledger.
+	ErrTransactionNotFound = errors.New("transaction not found")
+
+	// ErrTransactionExists happens when a transaction with the same ID is already committed.
+	ErrTransactionExists = errors.New("transaction already exists")
+
+	// ErrAlreadyReversed happens when reversing a transaction which already has a reversal entry.
+	ErrAlreadyReversed = errors.New("transaction already reversed")
+)
+
+// Side tells whether a posting debits or credits an account.
+type Side int
+
+const (
+	// Debit increases the balance of an account.
+	Debit Side = iota + 1
+	// Credit decreases the balance of an account.
+	Credit
+)
+
+// String returns the name of the side.
+func (s Side) String() string {
+	switch s {
+	case Debit:
+		return "debit"
+	case Credit:
+		return "credit"
+	default:
+		return fmt.Sprintf("Side(%d)", int(s))
+	}
+}
+
+// opposite returns the other side.
+func (s Side) opposite() Side {
+	if s == Debit {
+		return Credit
+	}
+	return Debit
+}
+
+// Account represents a ledger account.
+type Account struct {
+	ID   string
+	Name string
+}
+
+// Posting debits or credits an account with a positive amount.
+type Posting struct {
+	Account string
+	Side    Side
+	Amount  *money.Money
+}
+
+// Transaction groups postings which are committed together.
+// Reverses holds the ID of the transaction reversed by this one, if any.
+type Transaction struct {
+	ID          string
+	Description string
+	Time        time.Time
+	Postings    []Posting
+	Reverses    string
+}
+
+// Ledger validates and commits transactions to a Store and computes account balances.
+// It is safe for concurrent use when its Store is.
+type Ledger struct {
+	mu    sync.Mutex
+	store Store
+}
+
+// New creates new Ledger using the given store, a nil store creates a MemoryStore.
+func New(store Store) *Ledger {
+	if store == nil {
+		store = NewMemoryStore()
+	}
+
+	return &Ledger{store: store}
+}
+
+// OpenAccount adds the account to the ledger.
+func (l *Ledger) OpenAccount(a Account) error {
+	if a.ID == "" {
+		return errors.New("account ID is required")
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.store.Account(a.ID); err == nil {
+		return fmt.Errorf("%w: %s", ErrAccountExists, a.ID)
+	} else if !errors.Is(err, ErrAccountNotFound) {
+		return err
+	}
+
+	return l.store.SaveAccount(a)
+}
+
+// Account returns the account with the given ID.
+func (l *Ledger) Account(id string) (Account, error) {
+	return l.store.Account(id)
+}
+
+// Commit validates the transaction and saves it to the store.
+// It refuses transactions whose debits don't equal their credits in every currency.
+func (l *Ledger) Commit(tx Transaction) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.commit(tx)
+}
+
+func (l *Ledger) commit(tx Transaction) error {
+	if err := l.validate(tx); err != nil {
+		return err
+	}
+
+	return l.store.SaveTransaction(tx)
+}
+
+func (l *Ledger) validate(tx Transaction) error {
+	if tx.ID == "" || len(tx.Postings) < 2 {
+		return fmt.Errorf("%w: %q needs an ID and at least two postings", ErrInvalidTransaction, tx.ID)
+	}
+
+	if _, err := l.store.Transaction(tx.ID); err == nil {
+		return fmt.Errorf("%w: %s", ErrTransactionExists, tx.ID)
+	} else if !errors.Is(err, ErrTransactionNotFound) {
+		return err
+	}
+
+	debits := make(map[string]*money.Money)
+	credits := make(map[string]*money.Money)
+	for i, p := range tx.Postings {
+		if p.Account == "" || (p.Side != Debit && p.Side != Credit) || p.Amount == nil || p.Amount.Currency() == nil || p.Amount.Currency().Code == "" || !p.Amount.IsPositive() {
+			return fmt.Errorf("%w: posting %d of %s", ErrInvalidPosting, i, tx.ID)
+		}
+		if _, err := l.store.Account(p.Account); err != nil {
+			return err
+		}
+
+		totals := debits
+		if p.Side == Credit {
+			totals = credits
+		}
+		if err := addTo(totals, p.Amount); err != nil {
+			return err
+		}
+	}
+
+	for code := range credits {
+		if _, ok := debits[code]; !ok {
+			debits[code] = money.New(0, code)
+		}
+	}
+	for code, d := range debits {
+		c, ok := credits[code]
+		if !ok {
+			c = money.New(0, code)
+		}
+		if eq, err := d.Equals(c); err != nil || !eq {
+			return fmt.Errorf("%w: %s debits %s, credits %s", ErrUnbalanced, code, d.Display(), c.Display())
+		}
+	}
+
+	return nil
+}
+
+// Transaction returns the committed transaction with the given ID.
+func (l *Ledger) Transaction(id string) (Transaction, error) {
+	return l.store.Transaction(id)
+}
+
+// Transactions returns all committed transactions in commit order.
+func (l *Ledger) Transactions() ([]Transaction, error) {
+	return l.store.Transactions()
+}
+
+// Reverse commits a reversal entry of the transaction with the given ID, swapping
+// the side of each posting so its effect on every balance is cancelled.
+func (l *Ledger) Reverse(id, reversalID string, at time.Time) (Transaction, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	orig, err := l.store.Transaction(id)
+	if err != nil {
+		return Transaction{}, err
+	}
+
+	txs, err := l.store.Transactions()
+	if err != nil {
+		return Transaction{}, err
+	}
+	for _, tx := range txs {
+		if tx.Reverses == id {
+			return Transaction{}, fmt.Errorf("%w: %s by %s", ErrAlreadyReversed, id, tx.ID)
+		}
+	}
+
+	rev := Transaction{
+		ID:          reversalID,
+		Description: "Reversal of " + id,
+		Time:        at,
+		Postings:    make([]Posting, 0, len(orig.Postings)),
+		Reverses:    id,
+	}
+	for _, p := range orig.Postings {
+		rev.Postings = append(rev.Postings, Posting{Account: p.Account, Side: p.Side.opposite(), Amount: p.Amount})
+	}
+
+	if err := l.commit(rev); err != nil {
+		return Transaction{}, err
+	}
+
+	return rev, nil
+}
+
+// Balances returns the balance of the account in every currency it has postings in,
+// ordered by currency code. Debits increase and credits decrease the balance.
+func (l *Ledger) Balances(account string) ([]*money.Money, error) {
+	if _, err := l.store.Account(account); err != nil {
+		return nil, err
+	}
+
+	txs, err := l.store.Transactions()
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make(map[string]*money.Money)
+	for _, tx := range txs {
+		for _, p := range tx.Postings {
+			if p.Account != account {
+				continue
+			}
+			if err := applyTo(balances, p); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	codes := make([]string, 0, len(balances))
+	for code := range balances {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+
+	ms := make([]*money.Money, 0, len(codes))
+	for _, code := range codes {
+		ms = append(ms, balances[code])
+	}
+
+	return ms, nil
+}
+
+// Balance returns the balance of the account in the given currency.
+func (l *Ledger) Balance(account, currency string) (*money.Money, error) {
+	balances, err := l.Balances(account)
+	if err != nil {
+		return nil, err
+	}
+
+	zero := money.New(0, currency)
+	for _, b := range balances {
+		if b.SameCurrency(zero) {
+			return b, nil
+		}
+	}
+
+	return zero, nil
+}
+
+// addTo adds the amount to the total of its currency.
+func addTo(totals map[string]*money.Money, m *money.Money) error {
+	code := m.Currency().Code
+	total, ok := totals[code]
+	if !ok {
+		totals[code] = m
+		return nil
+	}
+
+	sum, err := total.Add(m)
+	if err != nil {
+		return err
+	}
+	totals[code] = sum
+
+	return nil
+}
+
+// applyTo adds debits to and subtracts credits from the balance of the posting currency.
+func applyTo(balances map[string]*money.Money, p Posting) error {
+	code := p.Amount.Currency().Code
+	balance, ok := balances[code]
+	if !ok {
+		balance = money.New(0, code)
+	}
+
+	var err error
+	if p.Side == Debit {
+		balance, err = balance.Add(p.Amount)
+	} else {
+		balance, err = balance.Subtract(p.Amount)
+	}
+	if err != nil {
+		return err
+	}
+	balances[code] = balance
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package ledger
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	money "github.com/rezaalavi/gmoney"
+)
+
+func newTestLedger(t *testing.T) *Ledger {
+	l := New(nil)
+	for _, id := range []string{"cash", "revenue", "receivables", "fx"} {
+		if err := l.OpenAccount(Account{ID: id, Name: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return l
+}
+
+func TestLedger_Commit(t *testing.T) {
+	l := newTestLedger(t)
+
+	err := l.Commit(Transaction{
+		ID: "inv-1",
+		Postings: []Posting{
+			{Account: "receivables", Side: Debit, Amount: money.New("100.00", money.EUR)},
+			{Account: "receivables", Side: Debit, Amount: money.New("20.00", money.USD)},
+			{Account: "revenue", Side: Credit, Amount: money.New("60.00", money.EUR)},
+			{Account: "revenue", Side: Credit, Amount: money.New("40.00", money.EUR)},
+			{Account: "revenue", Side: Credit, Amount: money.New("20.00", money.USD)},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txs, err := l.Transactions()
+	if err != nil || len(txs) != 1 {
+		t.Fatalf("Expected 1 transaction got %d, %v", len(txs), err)
+	}
+}
+
+func TestLedger_CommitErrors(t *testing.T) {
+	l := newTestLedger(t)
+	_ = l.Commit(Transaction{
+		ID: "tx-1",
+		Postings: []Posting{
+			{Account: "cash", Side: Debit, Amount: money.New(1, money.EUR)},
+			{Account: "revenue", Side: Credit, Amount: money.New(1, money.EUR)},
+		},
+	})
+
+	tcs := []struct {
+		name string
+		tx   Transaction
+		err  error
+	}{
+		{"unbalanced", Transaction{ID: "tx-2", Postings: []Posting{
+			{Account: "cash", Side: Debit, Amount: money.New(10, money.EUR)},
+			{Account: "revenue", Side: Credit, Amount: money.New("9.99", money.EUR)},
+		}}, ErrUnbalanced},
+		{"balanced across currencies only", Transaction{ID: "tx-2", Postings: []Posting{
+			{Account: "cash", Side: Debit, Amount: money.New(10, money.EUR)},
+			{Account: "revenue", Side: Credit, Amount: money.New(10, money.USD)},
+		}}, ErrUnbalanced},
+		{"unknown account", Transaction{ID: "tx-2", Postings: []Posting{
+			{Account: "cash", Side: Debit, Amount: money.New(10, money.EUR)},
+			{Account: "nope", Side: Credit, Amount: money.New(10, money.EUR)},
+		}}, ErrAccountNotFound},
+		{"negative amount", Transaction{ID: "tx-2", Postings: []Posting{
+			{Account: "cash", Side: Debit, Amount: money.New(-10, money.EUR)},
+			{Account: "revenue", Side: Credit, Amount: money.New(-10, money.EUR)},
+		}}, ErrInvalidPosting},
+		{"missing side", Transaction{ID: "tx-2", Postings: []Posting{
+			{Account: "cash", Amount: money.New(10, money.EUR)},
+			{Account: "revenue", Side: Credit, Amount: money.New(10, money.EUR)},
+		}}, ErrInvalidPosting},
+		{"single posting", Transaction{ID: "tx-2", Postings: []Posting{
+			{Account: "cash", Side: Debit, Amount: money.New(10, money.EUR)},
+		}}, ErrInvalidTransaction},
+		{"duplicate", Transaction{ID: "tx-1", Postings: []Posting{
+			{Account: "cash", Side: Debit, Amount: money.New(1, money.EUR)},
+			{Account: "revenue", Side: Credit, Amount: money.New(1, money.EUR)},
+		}}, ErrTransactionExists},
+	}
+
+	for _, tc := range tcs {
+		if err := l.Commit(tc.tx); !errors.Is(err, tc.err) {
+			t.Errorf("%s: expected %v got %v", tc.name, tc.err, err)
+		}
+	}
+
+	txs, _ := l.Transactions()
+	if len(txs) != 1 {
+		t.Errorf("Expected rejected transactions not to be committed, got %d transactions", len(txs))
+	}
+}
+
+func TestLedger_Balances(t *testing.T) {
+	l := newTestLedger(t)
+
+	for _, tx := range []Transaction{
+		{ID: "sale-1", Postings: []Posting{
+			{Account: "cash", Side: Debit, Amount: money.New("100.00", money.EUR)},
+			{Account: "revenue", Side: Credit, Amount: money.New("100.00", money.EUR)},
+		}},
+		{ID: "sale-2", Postings: []Posting{
+			{Account: "cash", Side: Debit, Amount: money.New("50.00", money.USD)},
+			{Account: "revenue", Side: Credit, Amount: money.New("50.00", money.USD)},
+		}},
+		{ID: "refund-1", Postings: []Posting{
+			{Account: "revenue", Side: Debit, Amount: money.New("25.50", money.EUR)},
+			{Account: "cash", Side: Credit, Amount: money.New("25.50", money.EUR)},
+		}},
+	} {
+		if err := l.Commit(tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tcs := []struct {
+		account  string
+		expected []string
+	}{
+		{"cash", []string{"€74.50", "$50.00"}},
+		{"revenue", []string{"-€74.50", "-$50.00"}},
+		{"fx", []string{}},
+	}
+
+	for _, tc := range tcs {
+		balances, err := l.Balances(tc.account)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rs := []string{}
+		for _, b := range balances {
+			rs = append(rs, b.Display())
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected balances of %s to be %v got %v", tc.account, tc.expected, rs)
+		}
+	}
+
+	b, err := l.Balance("cash", money.GBP)
+	if err != nil || b.Display() != "£0.00" {
+		t.Errorf("Expected %s got %s, %v", "£0.00", b.Display(), err)
+	}
+
+	if _, err := l.Balances("nope"); !errors.Is(err, ErrAccountNotFound) {
+		t.Errorf("Expected ErrAccountNotFound got %v", err)
+	}
+}
+
+func TestLedger_Reverse(t *testing.T) {
+	l := newTestLedger(t)
+	_ = l.Commit(Transaction{ID: "sale-1", Postings: []Posting{
+		{Account: "cash", Side: Debit, Amount: money.New("100.00", money.EUR)},
+		{Account: "revenue", Side: Credit, Amount: money.New("100.00", money.EUR)},
+	}})
+
+	at := time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC)
+	rev, err := l.Reverse("sale-1", "sale-1-rev", at)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rev.Reverses != "sale-1" || rev.Postings[0].Side != Credit || !rev.Time.Equal(at) {
+		t.Errorf("Unexpected reversal %+v", rev)
+	}
+
+	for _, account := range []string{"cash", "revenue"} {
+		b, err := l.Balance(account, money.EUR)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !b.IsZero() {
+			t.Errorf("Expected %s balance to be zero got %s", account, b.Display())
+		}
+	}
+
+	if _, err := l.Reverse("sale-1", "sale-1-rev2", at); !errors.Is(err, ErrAlreadyReversed) {
+		t.Errorf("Expected ErrAlreadyReversed got %v", err)
+	}
+
+	if _, err := l.Reverse("nope", "nope-rev", at); !errors.Is(err, ErrTransactionNotFound) {
+		t.Errorf("Expected ErrTransactionNotFound got %v", err)
+	}
+}
+
+func TestLedger_OpenAccount(t *testing.T) {
+	l := newTestLedger(t)
+
+	if err := l.OpenAccount(Account{ID: "cash"}); !errors.Is(err, ErrAccountExists) {
+		t.Errorf("Expected ErrAccountExists got %v", err)
+	}
+
+	if err := l.OpenAccount(Account{}); err == nil {
+		t.Error("Expected err")
+	}
+
+	a, err := l.Account("cash")
+	if err != nil || a.Name != "cash" {
+		t.Errorf("Unexpected account %+v, %v", a, err)
+	}
+}
+
+func TestMemoryStore_TransactionsAreCopies(t *testing.T) {
+	l := newTestLedger(t)
+	_ = l.Commit(Transaction{
+		ID: "tx-1",
+		Postings: []Posting{
+			{Account: "cash", Side: Debit, Amount: money.New(10, money.EUR)},
+			{Account: "revenue", Side: Credit, Amount: money.New(10, money.EUR)},
+		},
+	})
+
+	tx, _ := l.Transaction("tx-1")
+	tx.Postings[0].Side = Credit
+	txs, _ := l.Transactions()
+	txs[0].Postings[1].Amount = money.New(99, money.EUR)
+
+	r, _ := l.Transaction("tx-1")
+	if r.Postings[0].Side != Debit || r.Postings[1].Amount.String() != "10.00 EUR" {
+		t.Errorf("Expected committed transaction to be unchanged got %+v", r.Postings)
+	}
+
+	if b, _ := l.Balance("cash", money.EUR); b.String() != "10.00 EUR" {
+		t.Errorf("Expected balance %s got %s", "10.00 EUR", b)
+	}
+}
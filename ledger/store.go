@@ -0,0 +1,103 @@
+package ledger
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Store persists accounts and committed transactions.
+// Implementations return ErrAccountNotFound and ErrTransactionNotFound for unknown IDs.
+type Store interface {
+	SaveAccount(a Account) error
+	Account(id string) (Account, error)
+	SaveTransaction(tx Transaction) error
+	Transaction(id string) (Transaction, error)
+	// Transactions returns all saved transactions in the order they were saved.
+	Transactions() ([]Transaction, error)
+}
+
+// MemoryStore is an in-memory Store. It is safe for concurrent use.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	accounts map[string]Account
+	txs      []Transaction
+	index    map[string]int
+}
+
+// NewMemoryStore creates new empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		accounts: make(map[string]Account),
+		index:    make(map[string]int),
+	}
+}
+
+// SaveAccount adds or replaces the account.
+func (s *MemoryStore) SaveAccount(a Account) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.accounts[a.ID] = a
+	return nil
+}
+
+// Account returns the account with the given ID.
+func (s *MemoryStore) Account(id string) (Account, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	a, ok := s.accounts[id]
+	if !ok {
+		return Account{}, fmt.Errorf("%w: %s", ErrAccountNotFound, id)
+	}
+
+	return a, nil
+}
+
+// SaveTransaction appends the transaction.
+func (s *MemoryStore) SaveTransaction(tx Transaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.index[tx.ID]; ok {
+		return fmt.Errorf("%w: %s", ErrTransactionExists, tx.ID)
+	}
+
+	s.index[tx.ID] = len(s.txs)
+	s.txs = append(s.txs, copyTransaction(tx))
+
+	return nil
+}
+
+// Transaction returns the transaction with the given ID.
+func (s *MemoryStore) Transaction(id string) (Transaction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	i, ok := s.index[id]
+	if !ok {
+		return Transaction{}, fmt.Errorf("%w: %s", ErrTransactionNotFound, id)
+	}
+
+	return copyTransaction(s.txs[i]), nil
+}
+
+// Transactions returns all transactions in the order they were saved.
+func (s *MemoryStore) Transactions() ([]Transaction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	txs := make([]Transaction, len(s.txs))
+	for i, tx := range s.txs {
+		txs[i] = copyTransaction(tx)
+	}
+
+	return txs, nil
+}
+
+// copyTransaction copies the postings of the transaction so the stored one can't be changed through it.
+// Amounts are shared as Money is never changed in place.
+func copyTransaction(tx Transaction) Transaction {
+	tx.Postings = append([]Posting(nil), tx.Postings...)
+	return tx
+}
@@ -0,0 +1,224 @@
+package money
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/text/currency"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// currencyPatterns holds the CLDR standard currency patterns by locale, ¤ stands for the symbol.
+// An optional negative pattern follows a ";", otherwise negative amounts are prefixed by "-".
+// Locales not listed fall back to their parent locale and finally to the root pattern.
+var currencyPatterns = map[string]string{
+	"und":    "¤ #,##0.00",
+	"af":     "¤#,##0.00",
+	"ar":     "#,##0.00 ¤",
+	"bg":     "#,##0.00 ¤",
+	"bn":     "#,##,##0.00¤",
+	"ca":     "#,##0.00 ¤",
+	"cs":     "#,##0.00 ¤",
+	"da":     "#,##0.00 ¤",
+	"de":     "#,##0.00 ¤",
+	"de-AT":  "¤ #,##0.00",
+	"de-CH":  "¤ #,##0.00;¤-#,##0.00",
+	"el":     "#,##0.00 ¤",
+	"en":     "¤#,##0.00",
+	"en-IN":  "¤#,##,##0.00",
+	"es":     "#,##0.00 ¤",
+	"es-419": "¤#,##0.00",
+	"et":     "#,##0.00 ¤",
+	"fa":     "¤#,##0.00",
+	"fi":     "#,##0.00 ¤",
+	"fr":     "#,##0.00 ¤",
+	"he":     "#,##0.00 ¤",
+	"hi":     "¤#,##,##0.00",
+	"hr":     "#,##0.00 ¤",
+	"hu":     "#,##0.00 ¤",
+	"id":     "¤#,##0.00",
+	"it":     "#,##0.00 ¤",
+	"it-CH":  "¤ #,##0.00;¤-#,##0.00",
+	"ja":     "¤#,##0.00",
+	"ko":     "¤#,##0.00",
+	"lt":     "#,##0.00 ¤",
+	"lv":     "#,##0.00 ¤",
+	"ms":     "¤#,##0.00",
+	"nb":     "#,##0.00 ¤",
+	"nl":     "¤ #,##0.00;¤ -#,##0.00",
+	"pl":     "#,##0.00 ¤",
+	"pt":     "¤ #,##0.00",
+	"pt-PT":  "#,##0.00 ¤",
+	"ro":     "#,##0.00 ¤",
+	"ru":     "#,##0.00 ¤",
+	"sk":     "#,##0.00 ¤",
+	"sl":     "#,##0.00 ¤",
+	"sr":     "#,##0.00 ¤",
+	"sv":     "#,##0.00 ¤",
+	"th":     "¤#,##0.00",
+	"tr":     "¤#,##0.00",
+	"uk":     "#,##0.00 ¤",
+	"vi":     "#,##0.00 ¤",
+	"zh":     "¤#,##0.00",
+}
+
+// LocaleFormatter formats Money using the conventions of a locale instead of the currency's:
+// separators, symbol, symbol position, spacing and negative pattern.
+type LocaleFormatter struct {
+	Tag      language.Tag
+	Decimal  string
+	Thousand string
+	// Grouping holds the digit group sizes of the locale, e.g. "3;2" for Indian lakh and crore.
+	Grouping Grouping
+	// Pattern is the CLDR currency pattern of the locale, e.g. "#,##0.00 ¤" or "¤ #,##0.00;¤ -#,##0.00".
+	Pattern string
+}
+
+var localeFormatters sync.Map
+
+// NewLocaleFormatter creates new LocaleFormatter for the given locale using CLDR data.
+func NewLocaleFormatter(tag language.Tag) *LocaleFormatter {
+	f := &LocaleFormatter{Tag: tag, Pattern: currencyPattern(tag)}
+
+	// Probe the separators by formatting a known number using latin digits.
+	if latn, err := tag.SetTypeForKey("nu", "latn"); err == nil {
+		tag = latn
+	}
+	probe := message.NewPrinter(tag).Sprint(number.Decimal(1234567.25, number.Scale(2)))
+	seps := strings.FieldsFunc(probe, unicode.IsDigit)
+	if len(seps) > 0 {
+		f.Decimal = seps[len(seps)-1]
+	}
+	if len(seps) > 1 {
+		f.Thousand = seps[0]
+		f.Grouping = probeGrouping(probe[:strings.LastIndex(probe, f.Decimal)], f.Thousand)
+	}
+
+	return f
+}
+
+// probeGrouping returns the group sizes of the formatted integer digits of the probe.
+func probeGrouping(digits, thousand string) Grouping {
+	groups := strings.Split(digits, thousand)
+	primary := len(groups[len(groups)-1])
+	if len(groups) > 2 && len(groups[len(groups)-2]) != primary {
+		return groupingOf(primary, len(groups[len(groups)-2]))
+	}
+
+	return groupingOf(primary)
+}
+
+// localeFormatter returns a cached LocaleFormatter for the locale.
+func localeFormatter(tag language.Tag) *LocaleFormatter {
+	if f, ok := localeFormatters.Load(tag); ok {
+		return f.(*LocaleFormatter)
+	}
+
+	f, _ := localeFormatters.LoadOrStore(tag, NewLocaleFormatter(tag))
+	return f.(*LocaleFormatter)
+}
+
+// currencyPattern returns the currency pattern of the locale or of its closest parent.
+func currencyPattern(tag language.Tag) string {
+	// Only use the subtags given explicitly, guessed ones would skip regional patterns.
+	var parts []interface{}
+	if base, c := tag.Base(); c == language.Exact {
+		parts = append(parts, base)
+	}
+	if script, c := tag.Script(); c == language.Exact {
+		parts = append(parts, script)
+	}
+	if region, c := tag.Region(); c == language.Exact {
+		parts = append(parts, region)
+	}
+	t, err := language.Compose(parts...)
+	if err != nil {
+		return currencyPatterns["und"]
+	}
+
+	for ; ; t = t.Parent() {
+		if p, ok := currencyPatterns[t.String()]; ok {
+			return p
+		}
+		if t.IsRoot() {
+			return currencyPatterns["und"]
+		}
+	}
+}
+
+// Symbol returns the symbol of the currency in the locale falling back to the currency grapheme.
+func (f *LocaleFormatter) Symbol(c *Currency) string {
+	unit, err := currency.ParseISO(c.Code)
+	if err != nil {
+		return c.Grapheme
+	}
+
+	return message.NewPrinter(f.Tag).Sprint(currency.Symbol(unit))
+}
+
+// Formatter returns the Formatter of the currency using the locale conventions.
+func (f *LocaleFormatter) Formatter(c *Currency) *Formatter {
+	positive, _ := f.templates()
+	fm := NewFormatter(c.Fraction, f.Decimal, f.Thousand, f.Symbol(c), positive)
+	fm.Grouping = f.Grouping
+	fm.Rounding = c.roundingMode()
+	return fm
+}
+
+// Format returns string of formatted Money using the locale conventions.
+func (f *LocaleFormatter) Format(m *Money) string {
+	c := m.currency.get()
+	fm := f.Formatter(c)
+	if !m.amount.IsNegative() {
+		return fm.Format(m.amount)
+	}
+
+	_, negative := f.templates()
+	if negative == "" {
+		return fm.Format(m.amount)
+	}
+	fm.Template = negative
+	return fm.Format(m.amount.Abs())
+}
+
+// templates converts the pattern to Formatter templates, negative is empty when
+// the locale uses the default "-" prefix.
+func (f *LocaleFormatter) templates() (positive, negative string) {
+	pattern := f.Pattern
+	if i := strings.Index(pattern, ";"); i >= 0 {
+		pattern, negative = pattern[:i], patternTemplate(pattern[i+1:])
+	}
+
+	return patternTemplate(pattern), negative
+}
+
+// patternTemplate converts a CLDR pattern like "¤ #,##0.00" to a Formatter template like "$ 1".
+func patternTemplate(pattern string) string {
+	var b strings.Builder
+	number := false
+	for _, r := range pattern {
+		switch {
+		case r == '¤':
+			b.WriteString("$")
+		case r == '#' || r == '0' || r == ',' || r == '.':
+			if !number {
+				b.WriteString("1")
+				number = true
+			}
+		case r == '-':
+			b.WriteRune(r)
+		case unicode.IsSpace(r):
+			b.WriteString(" ")
+		}
+	}
+
+	return b.String()
+}
+
+// DisplayLocale lets represent Money struct as string using the conventions of the given locale.
+func (m *Money) DisplayLocale(tag language.Tag) string {
+	return localeFormatter(tag).Format(m)
+}
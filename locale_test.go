@@ -0,0 +1,64 @@
+package money
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestMoney_DisplayLocale(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		tag      string
+		expected string
+	}{
+		{"1234.56", EUR, "de", "1.234,56 €"},
+		{"1234.56", EUR, "en-IE", "€1,234.56"},
+		{"-1234.56", EUR, "en-IE", "-€1,234.56"},
+		{"-1234.56", EUR, "de-DE", "-1.234,56 €"},
+		{"1234.56", EUR, "nl", "€ 1.234,56"},
+		{"-1234.56", EUR, "nl", "€ -1.234,56"},
+		{"-1234.5", CHF, "de-CH", "CHF-1’234.50"},
+		{"1234.56", USD, "en-US", "$1,234.56"},
+		{"1234.56", USD, "en-GB", "US$1,234.56"},
+		{"1234.56", USD, "fr", "1\u00a0234,56 $US"},
+		{"1234", JPY, "ja", "￥1,234"},
+		{"1234.56", BRL, "pt-BR", "R$ 1.234,56"},
+		{"1234.567", KWD, "en", "KWD1,234.567"},
+		{"123456789", INR, "en-IN", "₹12,34,56,789.00"},
+		{"123456789", USD, "hi", "$12,34,56,789.00"},
+		{"123456789", INR, "en-US", "₹123,456,789.00"},
+	}
+
+	for _, tc := range tcs {
+		r := New(tc.amount, tc.code).DisplayLocale(language.MustParse(tc.tag))
+
+		if r != tc.expected {
+			t.Errorf("Expected %s %s in %s to be %q got %q", tc.amount, tc.code, tc.tag, tc.expected, r)
+		}
+	}
+}
+
+func TestNewLocaleFormatter(t *testing.T) {
+	tcs := []struct {
+		tag      string
+		decimal  string
+		thousand string
+		pattern  string
+	}{
+		{"en", ".", ",", "¤#,##0.00"},
+		{"de-AT", ",", " ", "¤ #,##0.00"},
+		{"es-MX", ".", ",", "¤#,##0.00"},
+		{"ar-EG", ".", ",", "#,##0.00 ¤"},
+		{"xx", ".", ",", "¤ #,##0.00"},
+	}
+
+	for _, tc := range tcs {
+		f := NewLocaleFormatter(language.Make(tc.tag))
+
+		if f.Decimal != tc.decimal || f.Thousand != tc.thousand || f.Pattern != tc.pattern {
+			t.Errorf("Expected %s to use %q %q %q got %q %q %q", tc.tag, tc.decimal, tc.thousand, tc.pattern, f.Decimal, f.Thousand, f.Pattern)
+		}
+	}
+}
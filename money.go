@@ -1,14 +1,17 @@
 package money
 
 import (
-	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/samber/lo"
 	"github.com/shopspring/decimal"
-	"github.com/spf13/cast"
 )
 
 // Injection points for backward compatibility.
@@ -16,7 +19,14 @@ import (
 //
 //	money.UnmarshalJSON = func (m *Money, b []byte) error { ... }
 //	money.MarshalJSON = func (m Money) ([]byte, error) { ... }
+//
+// Prefer RegisterJSONCodec and SetDefaultJSONCodec, or JSONAs for a single field,
+// which are safe to use concurrently.
 var (
+	// StrictAmount makes Amount and AsMajorUnits panic with ErrPrecisionLoss
+	// instead of silently returning a float64 that doesn't equal the amount.
+	StrictAmount = false
+
 	// UnmarshalJSON is injection point of json.Unmarshaller for money.Money
 	UnmarshalJSON = defaultUnmarshalJSON
 	// MarshalJSON is injection point of json.Marshaller for money.Money
@@ -25,55 +35,39 @@ var (
 	// ErrCurrencyMismatch happens when two compared Money don't have the same currency.
 	ErrCurrencyMismatch = errors.New("currencies don't match")
 
-	// ErrInvalidJSONUnmarshal happens when the default money.UnmarshalJSON fails to unmarshal Money because of invalid data.
-	ErrInvalidJSONUnmarshal = errors.New("invalid json unmarshal")
+	// ErrInvalidAmount happens when a value can't be parsed into an amount.
+	ErrInvalidAmount = errors.New("invalid amount")
 
-	one = decimal.NewFromInt(1)
-)
+	// ErrUnsupportedType happens when a value of an unsupported type is used as an amount.
+	ErrUnsupportedType = errors.New("unsupported amount type")
 
-var Zero = New(0, "")
+	// ErrDivisionByZero happens when Money is divided by zero.
+	ErrDivisionByZero = errors.New("division by zero")
 
-func defaultUnmarshalJSON(m *Money, b []byte) error {
-	data := make(map[string]interface{})
-	err := json.Unmarshal(b, &data)
-	if err != nil {
-		return err
-	}
+	// ErrPrecisionLoss happens when an amount can't be represented exactly in the requested form.
+	ErrPrecisionLoss = errors.New("amount would lose precision")
 
-	var amount float64
-	if amountRaw, ok := data["amount"]; ok {
-		amount, ok = amountRaw.(float64)
-		if !ok {
-			return ErrInvalidJSONUnmarshal
-		}
-	}
+	// ErrOverflow happens when an amount doesn't fit into the requested type.
+	ErrOverflow = errors.New("amount overflows")
 
-	var currency string
-	if currencyRaw, ok := data["currency"]; ok {
-		currency, ok = currencyRaw.(string)
-		if !ok {
-			return ErrInvalidJSONUnmarshal
-		}
-	}
+	// ErrInvalidJSONUnmarshal happens when the default money.UnmarshalJSON fails to unmarshal Money because of invalid data.
+	ErrInvalidJSONUnmarshal = errors.New("invalid json unmarshal")
+)
 
-	var ref *Money
-	if amount == 0 && currency == "" {
-		ref = &Money{}
-	} else {
-		ref = New(decimal.NewFromFloat(amount), currency)
-	}
+var Zero = New(0, "")
 
-	*m = *ref
-	return nil
+// AmountError records a value that couldn't be converted to an Amount.
+type AmountError struct {
+	Value any
+	Err   error
 }
 
-func defaultMarshalJSON(m Money) ([]byte, error) {
-	if m == (Money{}) {
-		m = *New(0, "")
-	}
+func (e *AmountError) Error() string {
+	return fmt.Sprintf("money: can't convert %#v (%T) to amount: %v", e.Value, e.Value, e.Err)
+}
 
-	buff := bytes.NewBufferString(fmt.Sprintf(`{"amount": %.`+cast.ToString(m.currency.Fraction)+`f, "currency": "%s"}`, m.Amount(), m.Currency().Code))
-	return buff.Bytes(), nil
+func (e *AmountError) Unwrap() error {
+	return e.Err
 }
 
 // Amount is a data structure that stores the amount being used for calculations.
@@ -95,6 +89,40 @@ func New(amount any, code string) *Money {
 	}
 }
 
+// NewE creates and returns new instance of Money.
+// Unlike New it returns an error instead of panicking when the amount can't be converted.
+func NewE(amount any, code string) (*Money, error) {
+	dec, err := convertToDecimal(amount)
+	if err != nil {
+		return nil, err
+	}
+	return &Money{
+		amount:   dec,
+		currency: newCurrency(code).get(),
+	}, nil
+}
+
+// NewFromMinorUnits creates and returns new instance of Money from an amount
+// in the currency's smallest unit, e.g. 100 represents £1.00 for GBP.
+func NewFromMinorUnits[T int | int64 | *big.Int](units T, code string) *Money {
+	currency := newCurrency(code).get()
+
+	var amount decimal.Decimal
+	switch v := any(units).(type) {
+	case int:
+		amount = decimal.New(int64(v), -currency.Fraction)
+	case int64:
+		amount = decimal.New(v, -currency.Fraction)
+	case *big.Int:
+		amount = decimal.NewFromBigInt(v, -currency.Fraction)
+	}
+
+	return &Money{
+		amount:   amount,
+		currency: currency,
+	}
+}
+
 // NewFromFloat creates and returns new instance of Money from a float64.
 // Always rounding trailing decimals down.
 func NewFromFloat(_amount float64, code string) *Money {
@@ -107,16 +135,80 @@ func (m *Money) Currency() *Currency {
 	return m.currency
 }
 
-// Amount returns a copy of the internal monetary value as an int64.
+// Amount returns a copy of the internal monetary value as a float64 truncated to the currency fraction.
+// The conversion may lose precision, set StrictAmount to panic instead or use AmountE.
 func (m *Money) Amount() float64 {
-	fraction := int32(2)
-	if m.currency != nil {
-		fraction = m.currency.Fraction
+	val, err := m.AmountE()
+	if err != nil && StrictAmount {
+		panic(err)
 	}
-	val, _ := m.amount.Truncate(fraction).Float64()
 	return val
 }
 
+// AmountE returns the internal monetary value as a float64 truncated to the currency fraction.
+// It returns ErrPrecisionLoss together with the value when the float64 doesn't equal the amount.
+func (m *Money) AmountE() (float64, error) {
+	val, _ := m.amount.Truncate(m.fraction()).Float64()
+	if !decimal.NewFromFloat(val).Equal(m.amount) {
+		return val, ErrPrecisionLoss
+	}
+	return val, nil
+}
+
+// AmountString returns the exact amount as a string with at least the currency fraction digits,
+// e.g. "12.50" for $12.5 and "1.005" for $1.005.
+func (m *Money) AmountString() string {
+	places := m.fraction()
+	if -m.amount.Exponent() > places {
+		places = -m.amount.Exponent()
+	}
+	return m.amount.StringFixed(places)
+}
+
+// AmountDecimal returns the amount rounded to given decimal places using the rounding mode.
+func (m *Money) AmountDecimal(places int32, mode RoundingMode) decimal.Decimal {
+	return mutate.calc.round(m.amount, places, mode)
+}
+
+// AmountRat returns the exact amount as a big.Rat.
+func (m *Money) AmountRat() *big.Rat {
+	return m.amount.Rat()
+}
+
+// fraction returns the currency fraction falling back to 2 when Money has no currency.
+func (m *Money) fraction() int32 {
+	if m.currency != nil {
+		return m.currency.Fraction
+	}
+	return 2
+}
+
+// MinorUnits returns the amount in the currency's smallest unit, e.g. 150 for £1.50.
+// It returns ErrPrecisionLoss if the amount is more precise than the currency fraction
+// and ErrOverflow if it doesn't fit into an int64.
+func (m *Money) MinorUnits() (int64, error) {
+	units, err := m.MinorUnitsBig()
+	if err != nil {
+		return 0, err
+	}
+	if !units.IsInt64() {
+		return 0, ErrOverflow
+	}
+
+	return units.Int64(), nil
+}
+
+// MinorUnitsBig returns the amount in the currency's smallest unit as a big.Int.
+// It returns ErrPrecisionLoss if the amount is more precise than the currency fraction.
+func (m *Money) MinorUnitsBig() (*big.Int, error) {
+	units := m.amount.Shift(m.fraction())
+	if !units.IsInteger() {
+		return nil, ErrPrecisionLoss
+	}
+
+	return units.BigInt(), nil
+}
+
 // SameCurrency check if given Money is equals by currency.
 func (m *Money) SameCurrency(om *Money) bool {
 	return m.currency.equals(om.currency)
@@ -296,12 +388,80 @@ func (m *Money) Multiply(muls ...any) *Money {
 	return &Money{amount: mutate.calc.multiply(m.amount, k.amount), currency: m.currency}
 }
 
-// Round returns new Money struct with value rounded to nearest zero.
+// MultiplyE returns new Money struct with value representing Self multiplied value by multiplier.
+// Unlike Multiply it returns an error instead of panicking on invalid multipliers.
+func (m *Money) MultiplyE(muls ...any) (*Money, error) {
+	if len(muls) == 0 {
+		return nil, errors.New("at least one multiplier is required to multiply")
+	}
+
+	k := decimal.NewFromInt(1)
+
+	for _, m2 := range muls {
+		dec, err := convertToDecimal(m2)
+		if err != nil {
+			return nil, err
+		}
+		k = mutate.calc.multiply(k, dec)
+	}
+
+	return &Money{amount: mutate.calc.multiply(m.amount, k), currency: m.currency}, nil
+}
+
+// Round returns new Money struct with value rounded to the nearest whole unit, ties away from zero.
 func (m *Money) Round() *Money {
-	return &Money{amount: mutate.calc.round(m.amount, 0), currency: m.currency}
+	return &Money{amount: mutate.calc.round(m.amount, 0, RoundHalfUp), currency: m.currency}
+}
+
+// RoundTo returns new Money struct with value rounded to given decimal places using the rounding mode.
+func (m *Money) RoundTo(places int32, mode RoundingMode) *Money {
+	return &Money{amount: mutate.calc.round(m.amount, places, mode), currency: m.currency}
 }
+
+// RoundToCurrency returns new Money struct with value rounded to the currency fraction
+// using the currency's rounding mode, or RoundHalfUp when the currency has none.
+func (m *Money) RoundToCurrency() *Money {
+	return m.RoundTo(m.currency.Fraction, m.currency.explicitRoundingMode())
+}
+
+// RoundToCash returns new Money struct with value rounded to the currency's cash increment,
+// e.g. to the nearest 0.05 for CHF. Currencies without a cash increment round to their minor unit
+// like RoundToCurrency. The rounding mode is the same as for RoundToCurrency.
+func (m *Money) RoundToCash() *Money {
+	inc := m.currency.cashIncrement()
+	units := mutate.calc.divide(m.amount, inc, 0, m.currency.explicitRoundingMode())
+	return &Money{amount: mutate.calc.multiply(units, inc), currency: m.currency}
+}
+
+// CashRoundingDifference returns new Money struct with the amount added by RoundToCash,
+// negative when the cash amount is lower, ready to be shown as a rounding line on a receipt.
+func (m *Money) CashRoundingDifference() *Money {
+	return &Money{amount: mutate.calc.subtract(m.RoundToCash().amount, m.amount), currency: m.currency}
+}
+
+// Divide returns new Money struct with value representing Self divided by given amount.
+// The result is rounded to the currency fraction using the currency's rounding mode.
 func (m *Money) Divide(amount any) *Money {
-	return &Money{amount: mutate.calc.divide(m.amount, ConvertToDecimal(amount), m.currency.Fraction), currency: m.currency}
+	return m.DivideWithMode(amount, m.currency.roundingMode())
+}
+
+// DivideWithMode returns new Money struct with value representing Self divided by given amount,
+// rounded to the currency fraction using the given rounding mode.
+func (m *Money) DivideWithMode(amount any, mode RoundingMode) *Money {
+	return &Money{amount: mutate.calc.divide(m.amount, ConvertToDecimal(amount), m.currency.Fraction, mode), currency: m.currency}
+}
+
+// DivideE returns new Money struct with value representing Self divided by given amount.
+// Unlike Divide it returns an error instead of panicking on invalid or zero divisors.
+func (m *Money) DivideE(amount any) (*Money, error) {
+	d, err := convertToDecimal(amount)
+	if err != nil {
+		return nil, err
+	}
+	if d.IsZero() {
+		return nil, ErrDivisionByZero
+	}
+	return &Money{amount: mutate.calc.divide(m.amount, d, m.currency.Fraction, m.currency.roundingMode()), currency: m.currency}, nil
 }
 
 func (m *Money) setCurrency(code string) *Money {
@@ -310,91 +470,103 @@ func (m *Money) setCurrency(code string) *Money {
 }
 
 // Split returns slice of Money structs with split Self value in given number.
-// After division leftover pennies will be distributed round-robin amongst the parties.
+// Parts are rounded using the currency's rounding mode and after division leftover pennies
+// will be distributed round-robin amongst the parties.
 // This means that parties listed first will likely receive more pennies than ones that are listed later.
 func (m *Money) Split(n int) ([]*Money, error) {
+	return m.SplitWithMode(n, m.currency.roundingMode())
+}
+
+// SplitWithMode is like Split but rounds the parts using the given rounding mode.
+func (m *Money) SplitWithMode(n int, mode RoundingMode) ([]*Money, error) {
 	if n <= 0 {
 		return nil, errors.New("split must be higher than zero")
 	}
 
-	a := mutate.calc.divide(m.amount, decimal.NewFromInt(int64(n)), m.currency.Fraction)
+	a := mutate.calc.divide(m.amount, decimal.NewFromInt(int64(n)), m.currency.Fraction, mode)
 	ms := make([]*Money, n)
 
 	for i := 0; i < n; i++ {
 		ms[i] = &Money{amount: a, currency: m.currency}
 	}
 
-	r := mutate.calc.modulus(m.amount, decimal.NewFromInt(int64(n)), m.currency.Fraction)
-	// l := mutate.calc.absolute(r)
 	// Add leftovers to the first parties.
-	v := one.Div(decimal.NewFromInt(10).Pow(decimal.NewFromInt32(m.currency.Fraction)))
-	// v := one
-	if m.amount.IsNegative() {
-		v = v.Neg()
-	}
-	for p := 0; !r.IsZero(); p++ {
-		ms[p].amount = mutate.calc.add(ms[p].amount, v)
-		r = r.Sub(v)
-		if p == n-1 {
-			p = 0
-		}
-	}
+	r := mutate.calc.subtract(m.amount, mutate.calc.multiply(a, decimal.NewFromInt(int64(n))))
+	distributeLeftover(ms, r, m.currency.minorUnit())
 
 	return ms, nil
 }
 
 // Allocate returns slice of Money structs with split Self value in given ratios.
 // It lets split money by given ratios without losing pennies and as Split operations distributes
-// leftover pennies amongst the parties with round-robin principle.
-// func (m *Money) Allocate(rs ...int) ([]*Money, error) {
-// 	if len(rs) == 0 {
-// 		return nil, errors.New("no ratios specified")
-// 	}
-
-// 	// Calculate sum of ratios.
-// 	var sum decimal.Decimal
-// 	for _, r := range rs {
-// 		if r < 0 {
-// 			return nil, errors.New("negative ratios not allowed")
-// 		}
-// 		if int64(r) > (math.MaxInt64 - sum) {
-// 			return nil, errors.New("sum of given ratios exceeds max int")
-// 		}
-// 		sum += int64(r)
-// 	}
-
-// 	var total int64
-// 	ms := make([]*Money, 0, len(rs))
-// 	for _, r := range rs {
-// 		party := &Money{
-// 			amount:   mutate.calc.allocate(m.amount, r, sum, m.currency.Fraction),
-// 			currency: m.currency,
-// 		}
-
-// 		ms = append(ms, party)
-// 		total += party.amount
-// 	}
-
-// 	// if the sum of all ratios is zero, then we just returns zeros and don't do anything
-// 	// with the leftover
-// 	if sum == 0 {
-// 		return ms, nil
-// 	}
-
-// 	// Calculate leftover value and divide to first parties.
-// 	lo := m.amount - total
-// 	sub := int64(1)
-// 	if lo < 0 {
-// 		sub = -sub
-// 	}
-
-// 	for p := 0; lo != 0; p++ {
-// 		ms[p].amount = mutate.calc.add(ms[p].amount, sub)
-// 		lo -= sub
-// 	}
-
-// 	return ms, nil
-// }
+// leftover pennies amongst the parties with a non-zero ratio with round-robin principle.
+// Ratios can be of any type supported by ConvertToDecimal.
+func (m *Money) Allocate(rs ...any) ([]*Money, error) {
+	if len(rs) == 0 {
+		return nil, errors.New("no ratios specified")
+	}
+
+	// Calculate sum of ratios.
+	ratios := make([]decimal.Decimal, 0, len(rs))
+	sum := decimal.Zero
+	for _, r := range rs {
+		d, err := convertToDecimal(r)
+		if err != nil {
+			return nil, err
+		}
+		if d.IsNegative() {
+			return nil, errors.New("negative ratios not allowed")
+		}
+		ratios = append(ratios, d)
+		sum = mutate.calc.add(sum, d)
+	}
+
+	total := decimal.Zero
+	ms := make([]*Money, 0, len(ratios))
+	// Parties with a zero ratio get nothing, not even leftover pennies.
+	var sharing []*Money
+	for _, r := range ratios {
+		party := &Money{
+			amount:   mutate.calc.allocate(m.amount, r, sum, m.currency.Fraction),
+			currency: m.currency,
+		}
+
+		ms = append(ms, party)
+		if !r.IsZero() {
+			sharing = append(sharing, party)
+		}
+		total = mutate.calc.add(total, party.amount)
+	}
+
+	// if the sum of all ratios is zero, then we just returns zeros and don't do anything
+	// with the leftover
+	if sum.IsZero() {
+		return ms, nil
+	}
+
+	// Calculate leftover value and divide to first parties with a non-zero ratio.
+	distributeLeftover(sharing, mutate.calc.subtract(m.amount, total), m.currency.minorUnit())
+
+	return ms, nil
+}
+
+// distributeLeftover adds the leftover to the parties round-robin one unit at a time.
+// Amounts more precise than the unit leave a remainder, which goes to the first party
+// so the parts still add up to the original amount.
+func distributeLeftover(ms []*Money, lo Amount, unit Amount) {
+	if lo.IsNegative() {
+		unit = unit.Neg()
+	}
+
+	for p := 0; lo.Abs().GreaterThanOrEqual(unit.Abs()); p = (p + 1) % len(ms) {
+		ms[p].amount = mutate.calc.add(ms[p].amount, unit)
+		lo = mutate.calc.subtract(lo, unit)
+	}
+
+	if !lo.IsZero() {
+		ms[0].amount = mutate.calc.add(ms[0].amount, lo)
+	}
+}
 
 // Display lets represent Money struct as string in given Currency value.
 func (m *Money) Display() string {
@@ -402,6 +574,17 @@ func (m *Money) Display() string {
 	return c.Formatter().Format(m.amount)
 }
 
+// DisplayWith is like Display but uses the given options instead of DefaultDisplayOptions.
+func (m *Money) DisplayWith(opts DisplayOptions) string {
+	f := m.currency.get().Formatter()
+	f.Negative = opts.Negative
+	f.Sign = opts.Sign
+	if opts.Rounding != 0 {
+		f.Rounding = opts.Rounding
+	}
+	return f.Format(m.amount)
+}
+
 // Similar to Display but without the currency symbol
 func (m *Money) Simple() string {
 	c := *m.currency.get()
@@ -413,11 +596,82 @@ func (m *Money) Simple() string {
 func (m *Money) AsMajorUnits() float64 {
 	c := m.currency.get()
 	if c.Fraction == 0 {
+		if StrictAmount && !m.amount.IsInteger() {
+			panic(ErrPrecisionLoss)
+		}
 		return float64(m.amount.Round(0).IntPart())
 	}
 	return m.Amount()
 }
 
+// String returns the canonical form of Money with the exact amount and the currency code, e.g. "12.50 USD".
+// Money without a currency has only the amount, e.g. "0.00".
+func (m *Money) String() string {
+	if m == nil {
+		return "<nil>"
+	}
+	if m.currency == nil || m.currency.Code == "" {
+		return m.AmountString()
+	}
+	return m.AmountString() + " " + m.currency.Code
+}
+
+// Format is implementation of fmt.Formatter. It supports the verbs:
+//
+//	%v  the canonical form as String, %+v also signs positive amounts, e.g. "+12.50 USD"
+//	%s  the displayed form as Display, e.g. "$12.50"
+//	%q  the displayed form double-quoted
+//	%d  the amount in minor units, e.g. 1250
+//	%f  the amount with the currency fraction or the given precision, e.g. %.3f gives 12.500
+//
+// Amounts are rounded using the currency's rounding mode, width and the '-' flag pad the result.
+func (m *Money) Format(s fmt.State, verb rune) {
+	if m == nil {
+		formatPad(s, "<nil>")
+		return
+	}
+
+	c := m.currency.get()
+	mode := c.roundingMode()
+	sign := ""
+	if s.Flag('+') && m.amount.IsPositive() {
+		sign = "+"
+	}
+
+	switch verb {
+	case 'v':
+		formatPad(s, sign+m.String())
+	case 's':
+		formatPad(s, m.Display())
+	case 'q':
+		formatPad(s, fmt.Sprintf("%q", m.Display()))
+	case 'd':
+		formatPad(s, sign+mode.Round(m.amount, c.Fraction).Shift(c.Fraction).String())
+	case 'f', 'F':
+		places, ok := s.Precision()
+		if !ok {
+			places = int(c.Fraction)
+		}
+		formatPad(s, sign+mode.Round(m.amount, int32(places)).StringFixed(int32(places)))
+	default:
+		fmt.Fprintf(s, "%%!%c(*money.Money=%s)", verb, m.String())
+	}
+}
+
+// formatPad writes the string padding it to the width of the fmt.State.
+func formatPad(s fmt.State, str string) {
+	width, ok := s.Width()
+	if n := utf8.RuneCountInString(str); ok && n < width {
+		if s.Flag('-') {
+			str += strings.Repeat(" ", width-n)
+		} else {
+			str = strings.Repeat(" ", width-n) + str
+		}
+	}
+
+	_, _ = io.WriteString(s, str)
+}
+
 // UnmarshalJSON is implementation of json.Unmarshaller
 func (m *Money) UnmarshalJSON(b []byte) error {
 	return UnmarshalJSON(m, b)
@@ -428,6 +682,38 @@ func (m Money) MarshalJSON() ([]byte, error) {
 	return MarshalJSON(m)
 }
 
+// MarshalText is implementation of encoding.TextMarshaler, it uses the form "USD 12.50".
+// Money without a currency, like the zero value, marshals to an empty text.
+func (m Money) MarshalText() ([]byte, error) {
+	if m.currency == nil || m.currency.Code == "" {
+		return []byte{}, nil
+	}
+
+	return []byte(m.currency.Code + " " + m.AmountString()), nil
+}
+
+// UnmarshalText is implementation of encoding.TextUnmarshaler, it accepts "USD 12.50" and "12.50 USD".
+// It returns ErrUnknownCurrency if the currency isn't registered.
+func (m *Money) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*m = Money{}
+		return nil
+	}
+
+	amount, code, err := parseCodeAmount(string(text))
+	if err != nil {
+		return err
+	}
+
+	c := GetCurrency(code)
+	if c == nil {
+		return fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+	}
+
+	*m = Money{amount: amount, currency: c}
+	return nil
+}
+
 // Compare function compares two money of the same type
 //
 //	if m.amount > om.amount returns (1, nil)
@@ -443,44 +729,103 @@ func (m *Money) Compare(om *Money) (int, error) {
 	return m.compare(om), nil
 }
 
+// ConvertToDecimal converts the given value to a decimal.Decimal.
+// It panics if the value is not a supported type or can't be parsed,
+// use ParseAmount or the error-returning constructors to handle such input.
 func ConvertToDecimal[T any](value T) decimal.Decimal {
-	switch v := any(value).(type) {
+	dec, err := convertToDecimal(value)
+	if err != nil {
+		panic(err)
+	}
+	return dec
+}
+
+// ParseAmount converts the given value to an Amount.
+// It accepts the same types as ConvertToDecimal but returns an *AmountError
+// instead of panicking when the value can't be converted.
+func ParseAmount(value any) (Amount, error) {
+	return convertToDecimal(value)
+}
+
+// checkFloat rejects NaN and infinite floats, which have no decimal representation.
+func checkFloat(value any, f float64) error {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return &AmountError{Value: value, Err: fmt.Errorf("%w: %v is not a finite number", ErrInvalidAmount, f)}
+	}
+
+	return nil
+}
+
+func convertToDecimal(value any) (decimal.Decimal, error) {
+	switch v := value.(type) {
 	case int:
-		return decimal.NewFromInt(int64(v))
+		return decimal.NewFromInt(int64(v)), nil
 	case int8:
-		return decimal.NewFromInt(int64(v))
+		return decimal.NewFromInt(int64(v)), nil
 	case int16:
-		return decimal.NewFromInt(int64(v))
+		return decimal.NewFromInt(int64(v)), nil
 	case int32:
-		return decimal.NewFromInt(int64(v))
+		return decimal.NewFromInt(int64(v)), nil
 	case int64:
-		return decimal.NewFromInt(v)
+		return decimal.NewFromInt(v), nil
 	case uint:
-		return decimal.NewFromInt(int64(v))
+		return decimal.NewFromUint64(uint64(v)), nil
 	case uint8:
-		return decimal.NewFromInt(int64(v))
+		return decimal.NewFromInt(int64(v)), nil
 	case uint16:
-		return decimal.NewFromInt(int64(v))
+		return decimal.NewFromInt(int64(v)), nil
 	case uint32:
-		return decimal.NewFromInt(int64(v))
+		return decimal.NewFromInt(int64(v)), nil
 	case uint64:
-		return decimal.NewFromInt(int64(v))
+		return decimal.NewFromUint64(v), nil
 	case float32:
-		return decimal.NewFromFloat(float64(v))
+		if err := checkFloat(value, float64(v)); err != nil {
+			return decimal.Decimal{}, err
+		}
+		return decimal.NewFromFloat32(v), nil
 	case float64:
-		return decimal.NewFromFloat(float64(v))
-	case string:
-		dec, err := decimal.NewFromString(v)
-		if err != nil {
-			panic(err)
+		if err := checkFloat(value, v); err != nil {
+			return decimal.Decimal{}, err
 		}
-		return dec
+		return decimal.NewFromFloat(v), nil
+	case string:
+		return parseDecimalString(value, v)
+	case json.Number:
+		return parseDecimalString(value, string(v))
 	case decimal.Decimal:
-		return v
+		return v, nil
 	case *decimal.Decimal:
-		return *v
+		if v == nil {
+			return decimal.Decimal{}, &AmountError{Value: value, Err: ErrInvalidAmount}
+		}
+		return *v, nil
+	case *big.Int:
+		if v == nil {
+			return decimal.Decimal{}, &AmountError{Value: value, Err: ErrInvalidAmount}
+		}
+		return decimal.NewFromBigInt(v, 0), nil
+	case *big.Rat:
+		if v == nil {
+			return decimal.Decimal{}, &AmountError{Value: value, Err: ErrInvalidAmount}
+		}
+		return decimal.NewFromBigRat(v, int32(decimal.DivisionPrecision)), nil
+	case *Money:
+		if v == nil {
+			return decimal.Decimal{}, &AmountError{Value: value, Err: ErrInvalidAmount}
+		}
+		return v.amount, nil
+	case fmt.Stringer:
+		return parseDecimalString(value, v.String())
 
 	default:
-		panic("unsupported type")
+		return decimal.Decimal{}, &AmountError{Value: value, Err: ErrUnsupportedType}
+	}
+}
+
+func parseDecimalString(value any, s string) (decimal.Decimal, error) {
+	dec, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Decimal{}, &AmountError{Value: value, Err: fmt.Errorf("%w: %v", ErrInvalidAmount, err)}
 	}
+	return dec, nil
 }
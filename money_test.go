@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"math/big"
 	"reflect"
 	"testing"
 
@@ -589,59 +591,102 @@ func TestMoney_Split2(t *testing.T) {
 	}
 }
 
-// func TestMoney_Allocate(t *testing.T) {
-// 	tcs := []struct {
-// 		amount   decimal.Decimal
-// 		ratios   []int
-// 		expected []int64
-// 	}{
-// 		{100, []int{50, 50}, []int64{50, 50}},
-// 		{100, []int{30, 30, 30}, []int64{34, 33, 33}},
-// 		{200, []int{25, 25, 50}, []int64{50, 50, 100}},
-// 		{5, []int{50, 25, 25}, []int64{3, 1, 1}},
-// 		{decimal.NewFromInt(0), []int{decimal.NewFromInt(0), decimal.NewFromInt(0), decimal.NewFromInt(0), 0}, []int64{decimal.NewFromInt(0), decimal.NewFromInt(0), decimal.NewFromInt(0), 0}},
-// 		{decimal.NewFromInt(0), []int{50, 10}, []int64{0, 0}},
-// 		{10, []int{decimal.NewFromInt(0), 100}, []int64{decimal.NewFromInt(0), 10}},
-// 		{10, []int{decimal.NewFromInt(0), 0}, []int64{decimal.NewFromInt(0), 0}},
-// 	}
-
-// 	for _, tc := range tcs {
-// 		m := New(tc.amount, EUR)
-// 		var rs []int64
-// 		split, _ := m.Allocate(tc.ratios...)
-
-// 		for _, party := range split {
-// 			rs = append(rs, party.amount)
-// 		}
-
-// 		if !reflect.DeepEqual(tc.expected, rs) {
-// 			t.Errorf("Expected allocation of %d for ratios %v to be %v got %v", tc.amount, tc.ratios,
-// 				tc.expected, rs)
-// 		}
-// 	}
-// }
-
-// func TestMoney_Allocate2(t *testing.T) {
-// 	m := New(100, EUR)
-// 	r, err := m.Allocate()
-
-// 	if r != nil || err == nil {
-// 		t.Error("Expected err")
-// 	}
-// }
-
-// func TestAllocateOverflow(t *testing.T) {
-// 	m := New(math.MaxInt64, EUR)
-// 	_, err := m.Allocate(math.MaxInt, 1)
-// 	if err == nil {
-// 		t.Fatalf("expected an error, but got nil")
-// 	}
-
-// 	expectedErrorMessage := "sum of given ratios exceeds max int"
-// 	if err.Error() != expectedErrorMessage {
-// 		t.Fatalf("expected error message %q, but got %q", expectedErrorMessage, err.Error())
-// 	}
-// }
+func TestMoney_Allocate(t *testing.T) {
+	tcs := []struct {
+		amount   float64
+		ratios   []any
+		expected []float64
+	}{
+		{1.00, []any{50, 50}, []float64{.50, .50}},
+		{1.00, []any{30, 30, 30}, []float64{.34, .33, .33}},
+		{2.00, []any{25, 25, 50}, []float64{.50, .50, 1.00}},
+		{.05, []any{50, 25, 25}, []float64{.03, .01, .01}},
+		{0, []any{0, 0, 0, 0}, []float64{0, 0, 0, 0}},
+		{0, []any{50, 10}, []float64{0, 0}},
+		{.10, []any{0, 100}, []float64{0, .10}},
+		{.10, []any{0, 0}, []float64{0, 0}},
+		{1.00, []any{"1", decimal.NewFromFloat(1.5), 0.5}, []float64{.34, .50, .16}},
+		{-1.00, []any{1, 1, 1}, []float64{-.34, -.33, -.33}},
+		{.03, []any{0, 1, 1}, []float64{0, .02, .01}},
+		{.05, []any{1, 0, 1, 0, 1}, []float64{.02, 0, .02, 0, .01}},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, EUR)
+		var rs []float64
+		split, _ := m.Allocate(tc.ratios...)
+
+		for _, party := range split {
+			rs = append(rs, party.Amount())
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected allocation of %f for ratios %v to be %v got %v", tc.amount, tc.ratios,
+				tc.expected, rs)
+		}
+	}
+}
+
+func TestMoney_Allocate2(t *testing.T) {
+	m := New(100, EUR)
+	r, err := m.Allocate()
+
+	if r != nil || err == nil {
+		t.Error("Expected err")
+	}
+
+	r, err = m.Allocate(1, -1)
+
+	if r != nil || err == nil {
+		t.Error("Expected err")
+	}
+
+	for _, tc := range []struct {
+		ratio any
+		err   error
+	}{
+		{"abc", ErrInvalidAmount},
+		{math.NaN(), ErrInvalidAmount},
+		{struct{}{}, ErrUnsupportedType},
+	} {
+		var amountErr *AmountError
+		r, err = m.Allocate(1, tc.ratio)
+
+		if r != nil || !errors.Is(err, tc.err) || !errors.As(err, &amountErr) {
+			t.Errorf("Expected ratio %v to fail with %v got %v", tc.ratio, tc.err, err)
+		}
+	}
+}
+
+func TestMoney_AllocateSumsToTotal(t *testing.T) {
+	tcs := []struct {
+		amount string
+		code   string
+		ratios []any
+	}{
+		{"100.00", EUR, []any{1, 1, 1}},
+		{"1000", JPY, []any{1, 2, 4}},
+		{"10.005", EUR, []any{1, 1, 1}},
+		{"-7.777", KWD, []any{3, 5, 11}},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, tc.code)
+		parties, err := m.Allocate(tc.ratios...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		sum, err := Sum(parties...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !sum.amount.Equal(m.amount) {
+			t.Errorf("Expected allocation of %s to add up to %s got %s", tc.amount, m.amount, sum.amount)
+		}
+	}
+}
 
 func TestMoney_Format(t *testing.T) {
 	tcs := []struct {
@@ -702,25 +747,25 @@ func TestMoney_AsMajorUnits(t *testing.T) {
 	}
 }
 
-// func TestMoney_Allocate3(t *testing.T) {
-// 	pound := New(100, GBP)
-// 	parties, err := pound.Allocate(33, 33, 33)
-// 	if err != nil {
-// 		t.Error(err)
-// 	}
+func TestMoney_Allocate3(t *testing.T) {
+	pound := New(1, GBP)
+	parties, err := pound.Allocate(33, 33, 33)
+	if err != nil {
+		t.Error(err)
+	}
 
-// 	if parties[0].Display() != "£0.34" {
-// 		t.Errorf("Expected %s got %s", "£0.34", parties[0].Display())
-// 	}
+	if parties[0].Display() != "£0.34" {
+		t.Errorf("Expected %s got %s", "£0.34", parties[0].Display())
+	}
 
-// 	if parties[1].Display() != "£0.33" {
-// 		t.Errorf("Expected %s got %s", "£0.33", parties[1].Display())
-// 	}
+	if parties[1].Display() != "£0.33" {
+		t.Errorf("Expected %s got %s", "£0.33", parties[1].Display())
+	}
 
-// 	if parties[2].Display() != "£0.33" {
-// 		t.Errorf("Expected %s got %s", "£0.33", parties[2].Display())
-// 	}
-// }
+	if parties[2].Display() != "£0.33" {
+		t.Errorf("Expected %s got %s", "£0.33", parties[2].Display())
+	}
+}
 
 func TestMoney_Comparison(t *testing.T) {
 	pound := New(100, GBP)
@@ -855,6 +900,30 @@ func TestDefaultMarshal(t *testing.T) {
 		t.Errorf("Expected %s got %s", expected, string(b))
 	}
 
+	given = New("1.005", USD)
+	expected = `{"amount":1.00,"currency":"USD"}`
+
+	b, err = json.Marshal(given)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+
+	given = New("-1.005", USD)
+	expected = `{"amount":-1.00,"currency":"USD"}`
+
+	b, err = json.Marshal(given)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+
 	given = &Money{}
 	expected = `{"amount":0.00,"currency":""}`
 
@@ -871,6 +940,7 @@ func TestDefaultMarshal(t *testing.T) {
 func TestCustomMarshal(t *testing.T) {
 	given := New(123.45, IQD)
 	expected := `{"amount":123.450,"currency_code":"IQD","currency_fraction":3}`
+	defer func() { MarshalJSON = defaultMarshalJSON }()
 	MarshalJSON = func(m Money) ([]byte, error) {
 		buff := bytes.NewBufferString(fmt.Sprintf(`{"amount": %.3f, "currency_code": "%s", "currency_fraction": %d}`, m.Amount(), m.Currency().Code, m.Currency().Fraction))
 		return buff.Bytes(), nil
@@ -934,6 +1004,7 @@ func TestDefaultUnmarshal(t *testing.T) {
 func TestCustomUnmarshal(t *testing.T) {
 	given := `{"amount": 100.12, "currency_code":"USD", "currency_fraction":2}`
 	expected := "$100.12"
+	defer func() { UnmarshalJSON = defaultUnmarshalJSON }()
 	UnmarshalJSON = func(m *Money, b []byte) error {
 		data := make(map[string]interface{})
 		err := json.Unmarshal(b, &data)
@@ -955,3 +1026,465 @@ func TestCustomUnmarshal(t *testing.T) {
 		t.Errorf("Expected %s got %s", expected, m.Display())
 	}
 }
+
+type stringerAmount struct{}
+
+func (stringerAmount) String() string { return "12.34" }
+
+func TestConvertToDecimal(t *testing.T) {
+	tcs := []struct {
+		value    any
+		expected string
+	}{
+		{json.Number("12.345"), "12.345"},
+		{big.NewInt(1234), "1234"},
+		{big.NewRat(1, 4), "0.25"},
+		{stringerAmount{}, "12.34"},
+		{New("9.99", USD), "9.99"},
+		{uint64(math.MaxUint64), "18446744073709551615"},
+		{uint(math.MaxInt64) + 1, "9223372036854775808"},
+	}
+
+	for _, tc := range tcs {
+		r := ConvertToDecimal(tc.value)
+
+		if r.String() != tc.expected {
+			t.Errorf("Expected %#v to convert to %s got %s", tc.value, tc.expected, r)
+		}
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tcs := []struct {
+		value any
+		err   error
+	}{
+		{"12.50", nil},
+		{"foo", ErrInvalidAmount},
+		{json.Number("1e"), ErrInvalidAmount},
+		{[]int{1}, ErrUnsupportedType},
+		{(*big.Int)(nil), ErrInvalidAmount},
+		{math.NaN(), ErrInvalidAmount},
+		{math.Inf(1), ErrInvalidAmount},
+		{math.Inf(-1), ErrInvalidAmount},
+		{float32(math.NaN()), ErrInvalidAmount},
+		{float32(math.Inf(1)), ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		_, err := ParseAmount(tc.value)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected parsing %#v to return %v got %v", tc.value, tc.err, err)
+		}
+
+		var ae *AmountError
+		if tc.err != nil && !errors.As(err, &ae) {
+			t.Errorf("Expected *AmountError got %T", err)
+		}
+	}
+}
+
+func TestNonFiniteAmounts(t *testing.T) {
+	m := New(10, USD)
+	for _, f := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		if _, err := NewE(f, USD); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected NewE(%v) to return %v got %v", f, ErrInvalidAmount, err)
+		}
+
+		if _, err := m.MultiplyE(f); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected MultiplyE(%v) to return %v got %v", f, ErrInvalidAmount, err)
+		}
+
+		if _, err := m.DivideE(f); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("Expected DivideE(%v) to return %v got %v", f, ErrInvalidAmount, err)
+		}
+	}
+}
+
+func TestNewE(t *testing.T) {
+	m, err := NewE("12.50", USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.Display() != "$12.50" {
+		t.Errorf("Expected %s got %s", "$12.50", m.Display())
+	}
+
+	m, err = NewE("twelve", USD)
+	if m != nil || !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected ErrInvalidAmount got %v", err)
+	}
+}
+
+func TestMoney_MultiplyE(t *testing.T) {
+	m := New(2, EUR)
+
+	r, err := m.MultiplyE("1.5", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Amount() != 6 {
+		t.Errorf("Expected %f got %f", 6.0, r.Amount())
+	}
+
+	if _, err = m.MultiplyE(); err == nil {
+		t.Error("Expected err")
+	}
+
+	if _, err = m.MultiplyE(struct{}{}); !errors.Is(err, ErrUnsupportedType) {
+		t.Errorf("Expected ErrUnsupportedType got %v", err)
+	}
+}
+
+func TestMoney_DivideE(t *testing.T) {
+	m := New(10, EUR)
+
+	r, err := m.DivideE("4")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.Amount() != 2.5 {
+		t.Errorf("Expected %f got %f", 2.5, r.Amount())
+	}
+
+	if _, err = m.DivideE(0); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Expected ErrDivisionByZero got %v", err)
+	}
+
+	if _, err = m.DivideE("x"); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("Expected ErrInvalidAmount got %v", err)
+	}
+}
+
+func TestNewFromMinorUnits(t *testing.T) {
+	big1e30, _ := new(big.Int).SetString("1000000000000000000000000000000", 10)
+	tcs := []struct {
+		m        *Money
+		expected string
+	}{
+		{NewFromMinorUnits(100, GBP), "1"},
+		{NewFromMinorUnits(int64(-1234), USD), "-12.34"},
+		{NewFromMinorUnits(1234, JPY), "1234"},
+		{NewFromMinorUnits(1234, KWD), "1.234"},
+		{NewFromMinorUnits(big1e30, EUR), "10000000000000000000000000000"},
+	}
+
+	for _, tc := range tcs {
+		if !tc.m.amount.Equal(decimal.RequireFromString(tc.expected)) {
+			t.Errorf("Expected %s got %s", tc.expected, tc.m.amount)
+		}
+	}
+}
+
+func TestMoney_MinorUnits(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		expected int64
+		err      error
+	}{
+		{"1.50", GBP, 150, nil},
+		{"-12.34", USD, -1234, nil},
+		{"1234", JPY, 1234, nil},
+		{"1.2345", KWD, 0, ErrPrecisionLoss},
+		{"0.001", EUR, 0, ErrPrecisionLoss},
+		{"92233720368547758.08", USD, 0, ErrOverflow},
+		{"92233720368547758.07", USD, math.MaxInt64, nil},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, tc.code).MinorUnits()
+
+		if !errors.Is(err, tc.err) || r != tc.expected {
+			t.Errorf("Expected minor units of %s %s to be %d, %v got %d, %v", tc.amount, tc.code, tc.expected, tc.err, r, err)
+		}
+	}
+
+	b, err := New("92233720368547758.08", USD).MinorUnitsBig()
+	if err != nil || b.String() != "9223372036854775808" {
+		t.Errorf("Expected %s got %s, %v", "9223372036854775808", b, err)
+	}
+}
+
+func TestMoney_AmountString(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		expected string
+	}{
+		{"12.5", USD, "12.50"},
+		{"1.005", USD, "1.005"},
+		{"-0.1", EUR, "-0.10"},
+		{"1234", JPY, "1234"},
+		{"12345678901234567890.12", USD, "12345678901234567890.12"},
+	}
+
+	for _, tc := range tcs {
+		r := New(tc.amount, tc.code).AmountString()
+
+		if r != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_AmountDecimal(t *testing.T) {
+	m := New("1.005", USD)
+
+	if r := m.AmountDecimal(2, RoundHalfUp); r.String() != "1.01" {
+		t.Errorf("Expected %s got %s", "1.01", r)
+	}
+
+	if r := m.AmountDecimal(2, RoundHalfEven); r.String() != "1" {
+		t.Errorf("Expected %s got %s", "1", r)
+	}
+}
+
+func TestMoney_AmountRat(t *testing.T) {
+	r := New("12345678901234567890.25", USD).AmountRat()
+	expected, _ := new(big.Rat).SetString("49382715604938271561/4")
+
+	if r.Cmp(expected) != 0 {
+		t.Errorf("Expected %s got %s", expected, r)
+	}
+}
+
+func TestMoney_AmountStrict(t *testing.T) {
+	if _, err := New("12.34", USD).AmountE(); err != nil {
+		t.Errorf("Expected no error got %v", err)
+	}
+
+	tcs := []*Money{
+		New("1.005", USD),
+		New("12345678901234567.89", USD),
+	}
+
+	for _, m := range tcs {
+		if _, err := m.AmountE(); !errors.Is(err, ErrPrecisionLoss) {
+			t.Errorf("Expected ErrPrecisionLoss for %s got %v", m.amount, err)
+		}
+
+		func() {
+			StrictAmount = true
+			defer func() {
+				StrictAmount = false
+				if r := recover(); r != ErrPrecisionLoss {
+					t.Errorf("Expected panic with ErrPrecisionLoss for %s got %v", m.amount, r)
+				}
+			}()
+			m.Amount()
+		}()
+	}
+}
+
+func TestMoney_DisplayWith(t *testing.T) {
+	m := New(-1234, GBP)
+
+	if r := m.DisplayWith(DisplayOptions{Negative: NegativeParentheses}); r != "(£1,234.00)" {
+		t.Errorf("Expected %s got %s", "(£1,234.00)", r)
+	}
+
+	if r := m.Negative().DisplayWith(DisplayOptions{Sign: SignAlways}); r != "+£1,234.00" {
+		t.Errorf("Expected %s got %s", "+£1,234.00", r)
+	}
+
+	DefaultDisplayOptions = DisplayOptions{Negative: NegativeTrailingMinus}
+	defer func() { DefaultDisplayOptions = DisplayOptions{} }()
+
+	if r := m.Display(); r != "£1,234.00-" {
+		t.Errorf("Expected %s got %s", "£1,234.00-", r)
+	}
+}
+
+func TestMoney_DisplayIndianGrouping(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		expected string
+	}{
+		{"123456789", INR, "₹12,34,56,789.00"},
+		{"100000", INR, "₹1,00,000.00"},
+		{"-1234567.5", NPR, "-₨12,34,567.50"},
+	}
+
+	for _, tc := range tcs {
+		r := New(tc.amount, tc.code).Display()
+
+		if r != tc.expected {
+			t.Errorf("Expected %s %s to be %s got %s", tc.amount, tc.code, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_String(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		expected string
+	}{
+		{"12.5", USD, "12.50 USD"},
+		{"-12.5", USD, "-12.50 USD"},
+		{"1.005", USD, "1.005 USD"},
+		{"1234", JPY, "1234 JPY"},
+		{"1.5", KWD, "1.500 KWD"},
+	}
+
+	for _, tc := range tcs {
+		r := New(tc.amount, tc.code).String()
+
+		if r != tc.expected {
+			t.Errorf("Expected %s %s to be %s got %s", tc.amount, tc.code, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_FmtFormatter(t *testing.T) {
+	tcs := []struct {
+		format   string
+		money    *Money
+		expected string
+	}{
+		{"%v", New("12.5", USD), "12.50 USD"},
+		{"%+v", New("12.5", USD), "+12.50 USD"},
+		{"%+v", New("-12.5", USD), "-12.50 USD"},
+		{"%+v", New(0, USD), "0.00 USD"},
+		{"%s", New("1234.5", USD), "$1,234.50"},
+		{"%q", New("12.5", EUR), `"€12.50"`},
+		{"%d", New("12.5", USD), "1250"},
+		{"%d", New("-12.5", KWD), "-12500"},
+		{"%+d", New("12", JPY), "+12"},
+		{"%f", New("12.5", USD), "12.50"},
+		{"%.3f", New("12.5", USD), "12.500"},
+		{"%.1f", New("12.56", USD), "12.5"},
+		{"%.0f", New("12.5", USD), "12"},
+		{"%10s", New("12.5", USD), "    $12.50"},
+		{"%-10v|", New("1", USD), "1.00 USD  |"},
+		{"%x", New("1", USD), "%!x(*money.Money=1.00 USD)"},
+		{"%v", (*Money)(nil), "<nil>"},
+		{"%v", &Money{}, "0.00"},
+		{"%+v", &Money{}, "0.00"},
+		{"%s", &Money{}, "0.00"},
+		{"%d", &Money{}, "0"},
+		{"%f", &Money{}, "0.00"},
+		{"%v", &Money{amount: decimal.NewFromInt(5)}, "5.00"},
+		{"error: %v", New("12.5", USD), "error: 12.50 USD"},
+	}
+
+	for _, tc := range tcs {
+		r := fmt.Sprintf(tc.format, tc.money)
+
+		if r != tc.expected {
+			t.Errorf("Expected %s to be %s got %s", tc.format, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_MarshalText(t *testing.T) {
+	tcs := []struct {
+		money    Money
+		expected string
+	}{
+		{*New("12.5", USD), "USD 12.50"},
+		{*New("-1.005", USD), "USD -1.005"},
+		{*New(1234, JPY), "JPY 1234"},
+		{Money{}, ""},
+		{Money{amount: decimal.NewFromInt(0)}, ""},
+		{*Zero, ""},
+		{*New(0, ""), ""},
+	}
+
+	for _, tc := range tcs {
+		b, err := tc.money.MarshalText()
+		if err != nil {
+			t.Error(err)
+		}
+
+		if string(b) != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, string(b))
+		}
+
+		var m Money
+		if err := m.UnmarshalText(b); err != nil {
+			t.Errorf("Expected %q to round-trip got %v", string(b), err)
+		}
+
+		if r, _ := m.MarshalText(); string(r) != string(b) {
+			t.Errorf("Expected %q to round-trip got %q", string(b), string(r))
+		}
+	}
+}
+
+func TestMoney_UnmarshalText(t *testing.T) {
+	tcs := []struct {
+		text     string
+		expected string
+		err      error
+	}{
+		{"USD 12.50", "12.50 USD", nil},
+		{"12.5 usd", "12.50 USD", nil},
+		{"EUR -0.125", "-0.125 EUR", nil},
+		{"XYZ 12.50", "", ErrUnknownCurrency},
+		{"USD", "", ErrInvalidAmount},
+		{"USD twelve", "", ErrInvalidAmount},
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		err := m.UnmarshalText([]byte(tc.text))
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %s to fail with %v got %v", tc.text, tc.err, err)
+		}
+
+		if tc.err == nil && m.String() != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, m.String())
+		}
+	}
+}
+
+func TestMoney_TextMapKey(t *testing.T) {
+	given := map[Money]string{*New("12.5", USD): "lunch"}
+	expected := `{"USD 12.50":"lunch"}`
+
+	b, err := json.Marshal(given)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+
+	var r map[Money]string
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Error(err)
+	}
+
+	for m, v := range r {
+		if m.String() != "12.50 USD" || v != "lunch" {
+			t.Errorf("Expected %s got %v", expected, r)
+		}
+	}
+}
+
+func TestMoney_StringWithoutCurrency(t *testing.T) {
+	var fromJSON Money
+	if err := json.Unmarshal([]byte(`{"amount":0,"currency":""}`), &fromJSON); err != nil {
+		t.Error(err)
+	}
+
+	var fromDB Money
+	if err := fromDB.Scan("0|"); err != nil {
+		t.Error(err)
+	}
+
+	for _, m := range []*Money{&fromJSON, &fromDB, {}} {
+		if r := fmt.Sprintf("%v", m); r != "0.00" {
+			t.Errorf("Expected %s got %s", "0.00", r)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrAmbiguousCurrency happens when a parsed symbol is used by several currencies
+	// and no default currency resolves it.
+	ErrAmbiguousCurrency = errors.New("ambiguous currency")
+
+	// ErrMissingCurrency happens when a parsed string has no currency and no default currency is given.
+	ErrMissingCurrency = errors.New("missing currency")
+
+	// numberPattern matches the digits of a formatted amount including grouping and decimal separators.
+	numberPattern = regexp.MustCompile(`\d(?:[\d.,'\s\x{00a0}\x{202f}]*\d)?`)
+	// plainNumberPattern matches a number once separators are normalized.
+	plainNumberPattern = regexp.MustCompile(`^\d+(\.\d+)?$`)
+)
+
+// ParseOptions configures Parse.
+type ParseOptions struct {
+	// Currency is the code used when the string has no symbol or code,
+	// or when its symbol is shared by several currencies.
+	Currency string
+}
+
+// Parse parses a formatted money string back into Money. It understands strings produced
+// by Display, e.g. "€1,234,567.89" or "1 234 kr", ISO-coded strings like "USD 12.50" or
+// "12.50 USD" and accounting negatives like "(12.50)" or "(12.50) USD".
+// Display strings are read using the Grapheme, Template, Decimal and Thousand of the currency.
+func Parse(s string, opts ParseOptions) (*Money, error) {
+	input := s
+	s = strings.TrimSpace(s)
+
+	// Accounting negatives wrap either the whole string or only the number in parentheses.
+	neg := false
+	if lp, rp := strings.Index(s, "("), strings.LastIndex(s, ")"); lp >= 0 || rp >= 0 {
+		if lp < 0 || rp < lp || strings.Count(s, "(") != 1 || strings.Count(s, ")") != 1 {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidAmount, input)
+		}
+		neg = true
+		s = strings.TrimSpace(s[:lp] + s[lp+1:rp] + s[rp+1:])
+	}
+
+	loc := numberPattern.FindAllStringIndex(s, -1)
+	if len(loc) != 1 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAmount, input)
+	}
+	number := s[loc[0][0]:loc[0][1]]
+	prefix, suffix := s[:loc[0][0]], s[loc[0][1]:]
+
+	var signs, minus int
+	prefix, suffix = stripSigns(prefix, &signs, &minus), stripSigns(suffix, &signs, &minus)
+	if signs > 1 || signs == 1 && neg {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAmount, input)
+	}
+	neg = neg || minus == 1
+
+	prefix, suffix = strings.TrimSpace(prefix), strings.TrimSpace(suffix)
+	if prefix != "" && suffix != "" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAmount, input)
+	}
+
+	currency, amount, err := resolve(prefix+suffix, prefix != "", number, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", err, input)
+	}
+	if neg {
+		amount = amount.Neg()
+	}
+
+	return &Money{amount: amount, currency: currency}, nil
+}
+
+// stripSigns removes plus and minus signs from s counting all signs in n and minus signs in minus.
+func stripSigns(s string, n, minus *int) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case '-', '\u2212':
+			*minus++
+		case '+':
+		default:
+			return r
+		}
+		*n++
+		return -1
+	}, s)
+}
+
+// resolve finds the currency of an ISO code or grapheme and parses the number using it.
+// Currencies sharing the grapheme are narrowed down to those whose template puts the symbol
+// on the same side, whose separators can read the number and whose fraction fits it,
+// before falling back to the default currency.
+func resolve(symbol string, before bool, number string, opts ParseOptions) (*Currency, decimal.Decimal, error) {
+	if symbol == "" {
+		if opts.Currency == "" {
+			return nil, decimal.Decimal{}, ErrMissingCurrency
+		}
+		c := newCurrency(opts.Currency).get()
+		amount, err := parseNumber(number, c, false)
+		return c, amount, err
+	}
+
+	if c := GetCurrency(symbol); c != nil && len(symbol) == 3 {
+		amount, err := parseNumber(number, c, true)
+		return c, amount, err
+	}
+
+	var candidates, placed []*Currency
+	for _, c := range currencies {
+		if c.Grapheme != symbol {
+			continue
+		}
+		candidates = append(candidates, c)
+		if strings.Index(c.Template, "$") < strings.Index(c.Template, "1") == before {
+			placed = append(placed, c)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, decimal.Decimal{}, fmt.Errorf("%w %q", ErrUnknownCurrency, symbol)
+	}
+	if len(placed) > 0 {
+		candidates = placed
+	}
+
+	amounts := make(map[*Currency]decimal.Decimal, len(candidates))
+	var readable, fitting []*Currency
+	var err error
+	for _, c := range candidates {
+		var amount decimal.Decimal
+		if amount, err = parseNumber(number, c, false); err != nil {
+			continue
+		}
+		amounts[c] = amount
+		readable = append(readable, c)
+		if -amount.Exponent() <= c.Fraction {
+			fitting = append(fitting, c)
+		}
+	}
+	if len(readable) == 0 {
+		return nil, decimal.Decimal{}, err
+	}
+	candidates = readable
+	if len(fitting) > 0 {
+		candidates = fitting
+	}
+
+	if len(candidates) == 1 {
+		return candidates[0], amounts[candidates[0]], nil
+	}
+
+	code := strings.ToUpper(opts.Currency)
+	codes := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Code == code {
+			return c, amounts[c], nil
+		}
+		codes = append(codes, c.Code)
+	}
+	sort.Strings(codes)
+
+	return nil, decimal.Decimal{}, fmt.Errorf("%w %q could be any of %s", ErrAmbiguousCurrency, symbol, strings.Join(codes, ", "))
+}
+
+// parseNumber normalizes the separators of a formatted number and parses it.
+// Display strings use the separators of the currency. ISO-coded strings are often
+// written in the canonical form instead, so the last separator is taken as the decimal
+// one when both are present and a single "." is always a decimal separator.
+// Digit groups must follow the grouping of the currency, so "12,50" isn't read as 1250.
+func parseNumber(number string, c *Currency, iso bool) (decimal.Decimal, error) {
+	// Spaces and apostrophes only ever separate digit groups, mark them like the thousand separator.
+	number = strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '\u00a0', '\u202f', '\'':
+			return '_'
+		}
+		return r
+	}, number)
+
+	dec, thousand := c.Decimal, c.Thousand
+	if iso {
+		dot, comma := strings.LastIndex(number, "."), strings.LastIndex(number, ",")
+		switch {
+		case dot >= 0 && comma >= 0 && dot > comma, dot >= 0 && comma < 0:
+			dec, thousand = ".", ","
+		case dot >= 0 && comma >= 0:
+			dec, thousand = ",", "."
+		}
+	}
+
+	if thousand != "" && strings.TrimSpace(thousand) != "" {
+		number = strings.ReplaceAll(number, thousand, "_")
+	}
+	if dec != "" && dec != "." {
+		number = strings.Replace(number, dec, ".", 1)
+	}
+
+	integer, fraction, _ := strings.Cut(number, ".")
+	if strings.Contains(integer, "_") && !validGroups(integer, c.Grouping) {
+		return decimal.Decimal{}, fmt.Errorf("%w: %q doesn't match the digit grouping of %s", ErrInvalidAmount, number, c.Code)
+	}
+	number = strings.ReplaceAll(integer, "_", "")
+	if fraction != "" {
+		number += "." + fraction
+	}
+
+	if !plainNumberPattern.MatchString(number) {
+		return decimal.Decimal{}, ErrInvalidAmount
+	}
+
+	return decimal.NewFromString(number)
+}
+
+// validGroups reports whether the digit groups separated by "_" follow the grouping.
+func validGroups(digits string, g Grouping) bool {
+	grouping := g.sizes()
+	groups := strings.Split(digits, "_")
+	for i := len(groups) - 1; i >= 0; i-- {
+		size := grouping[len(grouping)-1]
+		if j := len(groups) - 1 - i; j < len(grouping) {
+			size = grouping[j]
+		}
+
+		// The leftmost group may be shorter than its size, or of any length when left ungrouped.
+		n := len(groups[i])
+		if size <= 0 {
+			return i == 0 && n > 0
+		}
+		if n > size || n == 0 || i > 0 && n != size {
+			return false
+		}
+	}
+
+	return true
+}
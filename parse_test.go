@@ -0,0 +1,116 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tcs := []struct {
+		given  string
+		opts   ParseOptions
+		amount string
+		code   string
+	}{
+		{"€1,234,567.89", ParseOptions{}, "1234567.89", EUR},
+		{"-€1,234.50", ParseOptions{}, "-1234.5", EUR},
+		{"€-1,234.50", ParseOptions{}, "-1234.5", EUR},
+		{"(€1,234.50)", ParseOptions{}, "-1234.5", EUR},
+		{"1.00 .د.إ", ParseOptions{Currency: AED}, "1", AED},
+		{"1.000 .د.إ", ParseOptions{}, "1", JOD},
+		{"1 234 kr", ParseOptions{Currency: SEK}, "1234", SEK},
+		{"kr 1.234,50", ParseOptions{}, "1234.5", DKK},
+		{"$12.50", ParseOptions{Currency: USD}, "12.5", USD},
+		{"£0.01", ParseOptions{Currency: "gbp"}, "0.01", GBP},
+		{"USD 12.50", ParseOptions{}, "12.5", USD},
+		{"12.50 USD", ParseOptions{}, "12.5", USD},
+		{"-12.50 usd", ParseOptions{}, "-12.5", USD},
+		{"(12.50)", ParseOptions{Currency: USD}, "-12.5", USD},
+		{"(12.50) USD", ParseOptions{}, "-12.5", USD},
+		{"(€12.50)", ParseOptions{}, "-12.5", EUR},
+		{"EUR 1.234,56", ParseOptions{}, "1234.56", EUR},
+		{"DKK 12.50", ParseOptions{}, "12.5", DKK},
+		{"JPY 1,234", ParseOptions{}, "1234", JPY},
+		{"¥1,234", ParseOptions{}, "1234", JPY},
+		{"+12.50 USD", ParseOptions{}, "12.5", USD},
+		{"  12.50 USD ", ParseOptions{}, "12.5", USD},
+		{"1,234.56", ParseOptions{Currency: USD}, "1234.56", USD},
+		{"₹12,34,56,789.00", ParseOptions{Currency: INR}, "123456789", INR},
+		{"USD 1 234 567.50", ParseOptions{}, "1234567.5", USD},
+	}
+
+	for _, tc := range tcs {
+		m, err := Parse(tc.given, tc.opts)
+		if err != nil {
+			t.Errorf("Expected %q to parse got %v", tc.given, err)
+			continue
+		}
+
+		if m.Currency().Code != tc.code || m.ToDecimal().String() != tc.amount {
+			t.Errorf("Expected %q to parse to %s %s got %s %s", tc.given, tc.amount, tc.code, m.ToDecimal(), m.Currency().Code)
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tcs := []struct {
+		given string
+		opts  ParseOptions
+		err   error
+	}{
+		{"$12.50", ParseOptions{}, ErrAmbiguousCurrency},
+		{"$12.50", ParseOptions{Currency: EUR}, ErrAmbiguousCurrency},
+		{"12.50", ParseOptions{}, ErrMissingCurrency},
+		{"12.50 XYZ", ParseOptions{}, ErrUnknownCurrency},
+		{"$", ParseOptions{Currency: USD}, ErrInvalidAmount},
+		{"", ParseOptions{Currency: USD}, ErrInvalidAmount},
+		{"--12.50 USD", ParseOptions{}, ErrInvalidAmount},
+		{"(-12.50 USD)", ParseOptions{}, ErrInvalidAmount},
+		{"(12.50 USD", ParseOptions{}, ErrInvalidAmount},
+		{")12.50( USD", ParseOptions{}, ErrInvalidAmount},
+		{"USD 12.50 EUR", ParseOptions{}, ErrInvalidAmount},
+		{"12.50 USD 3", ParseOptions{}, ErrInvalidAmount},
+		{"€1.234.567,89", ParseOptions{}, ErrInvalidAmount},
+		{"12,50 EUR", ParseOptions{}, ErrInvalidAmount},
+		{"€12,50", ParseOptions{}, ErrInvalidAmount},
+		{"1,2,3 USD", ParseOptions{}, ErrInvalidAmount},
+		{"1,2345.00", ParseOptions{Currency: USD}, ErrInvalidAmount},
+		{"₹1,234,567.00", ParseOptions{Currency: INR}, ErrInvalidAmount},
+		{"$1,234", ParseOptions{}, ErrAmbiguousCurrency},
+	}
+
+	for _, tc := range tcs {
+		_, err := Parse(tc.given, tc.opts)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %v parsing %q got %v", tc.err, tc.given, err)
+		}
+	}
+}
+
+func TestParse_Display(t *testing.T) {
+	tcs := []struct {
+		amount string
+		code   string
+	}{
+		{"1234567.89", EUR},
+		{"-0.01", GBP},
+		{"1234", JPY},
+		{"-1234.567", KWD},
+		{"1234.5", CHF},
+		{"1234.5", DKK},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, tc.code)
+		r, err := Parse(m.Display(), ParseOptions{Currency: tc.code})
+		if err != nil {
+			t.Errorf("Expected %q to parse got %v", m.Display(), err)
+			continue
+		}
+
+		if eq, err := r.Equals(m); err != nil || !eq {
+			t.Errorf("Expected %q to parse to %s got %s", m.Display(), m.ToDecimal(), r.ToDecimal())
+		}
+	}
+}
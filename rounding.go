@@ -0,0 +1,89 @@
+package money
+
+import "github.com/shopspring/decimal"
+
+// RoundingMode specifies how amounts are rounded to a given number of decimal places.
+// The zero value means the mode isn't set and the package default is used.
+type RoundingMode int
+
+const (
+	// RoundTruncate rounds towards zero by dropping extra digits.
+	RoundTruncate RoundingMode = iota + 1
+	// RoundHalfEven rounds to the nearest neighbour, ties go to the even neighbour (banker's rounding).
+	RoundHalfEven
+	// RoundHalfUp rounds to the nearest neighbour, ties go away from zero.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest neighbour, ties go towards zero.
+	RoundHalfDown
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+)
+
+// DefaultRoundingMode is used when neither the call nor the Currency specifies a rounding mode.
+// It defaults to RoundTruncate, the historical behaviour of Divide and Split.
+var DefaultRoundingMode = RoundTruncate
+
+// String returns the name of the rounding mode.
+func (mode RoundingMode) String() string {
+	switch mode {
+	case RoundTruncate:
+		return "truncate"
+	case RoundHalfEven:
+		return "half-even"
+	case RoundHalfUp:
+		return "half-up"
+	case RoundHalfDown:
+		return "half-down"
+	case RoundCeiling:
+		return "ceiling"
+	case RoundFloor:
+		return "floor"
+	default:
+		return "default"
+	}
+}
+
+// Round returns the amount rounded to the given number of decimal places using the rounding mode.
+func (mode RoundingMode) Round(a Amount, places int32) Amount {
+	return mode.quo(a, decimal.NewFromInt(1), places)
+}
+
+// quo returns a divided by d rounded to the given number of decimal places.
+// The quotient is computed exactly, so ties are detected without any loss of precision.
+func (mode RoundingMode) quo(a, d Amount, places int32) Amount {
+	q, r := a.QuoRem(d, places)
+	if r.IsZero() {
+		return q
+	}
+
+	unit := decimal.New(int64(a.Sign()*d.Sign()), -places)
+	// Compare the remainder with half of the divisor's unit to find ties.
+	half := r.Abs().Mul(decimal.NewFromInt(2)).Cmp(d.Abs().Mul(unit.Abs()))
+
+	switch mode {
+	case RoundHalfEven:
+		if half > 0 || half == 0 && q.Shift(places).BigInt().Bit(0) == 1 {
+			return q.Add(unit)
+		}
+	case RoundHalfUp:
+		if half >= 0 {
+			return q.Add(unit)
+		}
+	case RoundHalfDown:
+		if half > 0 {
+			return q.Add(unit)
+		}
+	case RoundCeiling:
+		if unit.IsPositive() {
+			return q.Add(unit)
+		}
+	case RoundFloor:
+		if unit.IsNegative() {
+			return q.Add(unit)
+		}
+	}
+
+	return q
+}
@@ -0,0 +1,202 @@
+package money
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestRoundingMode_Round(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		mode     RoundingMode
+		expected string
+	}{
+		{"2.345", RoundTruncate, "2.34"},
+		{"-2.345", RoundTruncate, "-2.34"},
+		{"2.345", RoundHalfEven, "2.34"},
+		{"2.355", RoundHalfEven, "2.36"},
+		{"-2.345", RoundHalfEven, "-2.34"},
+		{"2.3451", RoundHalfEven, "2.35"},
+		{"2.345", RoundHalfUp, "2.35"},
+		{"-2.345", RoundHalfUp, "-2.35"},
+		{"2.344", RoundHalfUp, "2.34"},
+		{"2.345", RoundHalfDown, "2.34"},
+		{"-2.345", RoundHalfDown, "-2.34"},
+		{"2.3451", RoundHalfDown, "2.35"},
+		{"2.341", RoundCeiling, "2.35"},
+		{"-2.349", RoundCeiling, "-2.34"},
+		{"2.349", RoundFloor, "2.34"},
+		{"-2.341", RoundFloor, "-2.35"},
+		{"2.34", RoundCeiling, "2.34"},
+	}
+
+	for _, tc := range tcs {
+		r := tc.mode.Round(decimal.RequireFromString(tc.amount), 2)
+
+		if !r.Equal(decimal.RequireFromString(tc.expected)) {
+			t.Errorf("Expected %s rounded %s to be %s got %s", tc.amount, tc.mode, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_DivideWithMode(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		divisor  any
+		mode     RoundingMode
+		expected string
+	}{
+		{"1.00", 3, RoundTruncate, "0.33"},
+		{"2.00", 3, RoundTruncate, "0.66"},
+		{"2.00", 3, RoundHalfUp, "0.67"},
+		{"0.25", 2, RoundHalfEven, "0.12"},
+		{"0.35", 2, RoundHalfEven, "0.18"},
+		{"0.25", 2, RoundHalfDown, "0.12"},
+		{"-2.00", 3, RoundFloor, "-0.67"},
+		{"-2.00", 3, RoundCeiling, "-0.66"},
+		{"1.00", -3, RoundFloor, "-0.34"},
+	}
+
+	for _, tc := range tcs {
+		r := New(tc.amount, EUR).DivideWithMode(tc.divisor, tc.mode)
+
+		if !r.amount.Equal(decimal.RequireFromString(tc.expected)) {
+			t.Errorf("Expected %s / %v (%s) to be %s got %s", tc.amount, tc.divisor, tc.mode, tc.expected, r.amount)
+		}
+	}
+}
+
+func TestMoney_RoundTo(t *testing.T) {
+	m := New("12.345", EUR)
+
+	if r := m.RoundTo(2, RoundHalfEven); r.ToDecimal().String() != "12.34" {
+		t.Errorf("Expected %s got %s", "12.34", r.ToDecimal())
+	}
+
+	if r := m.RoundTo(0, RoundCeiling); r.ToDecimal().String() != "13" {
+		t.Errorf("Expected %s got %s", "13", r.ToDecimal())
+	}
+}
+
+func TestMoney_SplitWithMode(t *testing.T) {
+	tcs := []struct {
+		amount   float64
+		split    int
+		mode     RoundingMode
+		expected []float64
+	}{
+		{1.00, 3, RoundTruncate, []float64{.34, .33, .33}},
+		{2.00, 3, RoundHalfUp, []float64{.66, .67, .67}},
+		{.05, 3, RoundHalfEven, []float64{.01, .02, .02}},
+		{-2.00, 3, RoundFloor, []float64{-.66, -.67, -.67}},
+	}
+
+	for _, tc := range tcs {
+		split, err := New(tc.amount, EUR).SplitWithMode(tc.split, tc.mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var rs []float64
+		for _, party := range split {
+			rs = append(rs, party.Amount())
+		}
+
+		if !reflect.DeepEqual(tc.expected, rs) {
+			t.Errorf("Expected split of %f (%s) to be %v got %v", tc.amount, tc.mode, tc.expected, rs)
+		}
+	}
+}
+
+func TestCurrency_RoundingMode(t *testing.T) {
+	AddCurrency("HEV", "H", "$1", ".", ",", 2).Rounding = RoundHalfUp
+
+	avg, err := Average(New(1, "HEV"), New(1, "HEV"), New(0, "HEV"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if avg.Amount() != .67 {
+		t.Errorf("Expected %f got %f", .67, avg.Amount())
+	}
+
+	median, err := Median(New("0.01", "HEV"), New("0.02", "HEV"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if median.Amount() != .02 {
+		t.Errorf("Expected %f got %f", .02, median.Amount())
+	}
+
+	DefaultRoundingMode = RoundCeiling
+	defer func() { DefaultRoundingMode = RoundTruncate }()
+
+	if r := New(1, "EUR").Divide(3); r.Amount() != .34 {
+		t.Errorf("Expected %f got %f", .34, r.Amount())
+	}
+}
+
+func TestMoney_RoundToCurrency(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		expected string
+	}{
+		{"12.3456", EUR, "12.35"},
+		{"-12.3456", EUR, "-12.35"},
+		{"12.3449", EUR, "12.34"},
+		{"1234.5", JPY, "1235"},
+		{"1.23456", KWD, "1.235"},
+		{"1.239", USD, "1.24"},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, tc.code)
+		r := m.RoundToCurrency()
+
+		if !r.amount.Equal(decimal.RequireFromString(tc.expected)) {
+			t.Errorf("Expected %s %s rounded to currency to be %s got %s", tc.amount, tc.code, tc.expected, r.amount)
+		}
+
+		// Without a cash increment cash rounding is rounding to the currency.
+		if c := m.RoundToCash(); !c.amount.Equal(r.amount) {
+			t.Errorf("Expected %s %s rounded to cash to be %s got %s", tc.amount, tc.code, r.amount, c.amount)
+		}
+	}
+}
+
+func TestMoney_RoundToCash(t *testing.T) {
+	tcs := []struct {
+		amount     string
+		code       string
+		expected   string
+		difference string
+	}{
+		{"1.02", CHF, "1.00", "-0.02"},
+		{"1.025", CHF, "1.05", "0.025"},
+		{"1.03", CHF, "1.05", "0.02"},
+		{"-1.03", CHF, "-1.05", "-0.02"},
+		{"10.49", SEK, "10", "-0.49"},
+		{"10.50", SEK, "11", "0.50"},
+		{"7.24", DKK, "7.00", "-0.24"},
+		{"7.25", DKK, "7.50", "0.25"},
+		{"7.256", EUR, "7.26", "0.004"},
+	}
+
+	for _, tc := range tcs {
+		m := New(tc.amount, tc.code)
+		r := m.RoundToCash()
+
+		if !r.amount.Equal(decimal.RequireFromString(tc.expected)) {
+			t.Errorf("Expected %s %s rounded to cash to be %s got %s", tc.amount, tc.code, tc.expected, r.amount)
+		}
+
+		d := m.CashRoundingDifference()
+		if !d.amount.Equal(decimal.RequireFromString(tc.difference)) {
+			t.Errorf("Expected cash rounding difference of %s %s to be %s got %s", tc.amount, tc.code, tc.difference, d.amount)
+		}
+	}
+}
@@ -0,0 +1,237 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/shopspring/decimal"
+	"golang.org/x/text/language"
+)
+
+// ErrUnsupportedLanguage happens when no Speller is registered for a language.
+var ErrUnsupportedLanguage = errors.New("unsupported language")
+
+// Speller spells Money amounts in words in one language.
+type Speller interface {
+	Spell(m *Money) (string, error)
+}
+
+var (
+	spellersMu sync.RWMutex
+	spellers   = map[language.Tag]Speller{
+		language.English: &EnglishSpeller{Cheque: true},
+	}
+)
+
+// RegisterSpeller registers the Speller used by InWords for the language and the locales under it,
+// replacing the one registered before.
+func RegisterSpeller(lang language.Tag, s Speller) {
+	spellersMu.Lock()
+	defer spellersMu.Unlock()
+
+	spellers[lang] = s
+}
+
+// speller returns the Speller registered for the language or for its closest parent.
+func speller(lang language.Tag) (Speller, bool) {
+	spellersMu.RLock()
+	defer spellersMu.RUnlock()
+
+	for t := lang; ; t = t.Parent() {
+		if s, ok := spellers[t]; ok {
+			return s, true
+		}
+		if t.IsRoot() {
+			return nil, false
+		}
+	}
+}
+
+// InWords returns the amount spelled out in words in the given language as written on cheques,
+// e.g. "One thousand two hundred thirty-four dollars and 56/100" in English.
+func (m *Money) InWords(lang language.Tag) (string, error) {
+	s, ok := speller(lang)
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedLanguage, lang)
+	}
+
+	return s.Spell(m)
+}
+
+// UnitNames holds the singular and plural names of the major and minor units of a currency.
+type UnitNames struct {
+	Major       string
+	MajorPlural string
+	Minor       string
+	MinorPlural string
+}
+
+// EnglishUnitNames holds the English unit names by currency code.
+// Currencies without names are spelled with their code.
+var EnglishUnitNames = map[string]UnitNames{
+	AED: {"dirham", "dirhams", "fils", "fils"},
+	AUD: {"dollar", "dollars", "cent", "cents"},
+	BHD: {"dinar", "dinars", "fils", "fils"},
+	BRL: {"real", "reais", "centavo", "centavos"},
+	CAD: {"dollar", "dollars", "cent", "cents"},
+	CHF: {"franc", "francs", "centime", "centimes"},
+	CNY: {"yuan", "yuan", "fen", "fen"},
+	DKK: {"krone", "kroner", "øre", "øre"},
+	EUR: {"euro", "euros", "cent", "cents"},
+	GBP: {"pound", "pounds", "penny", "pence"},
+	HKD: {"dollar", "dollars", "cent", "cents"},
+	INR: {"rupee", "rupees", "paisa", "paise"},
+	IQD: {"dinar", "dinars", "fils", "fils"},
+	IRR: {"rial", "rials", "dinar", "dinars"},
+	JOD: {"dinar", "dinars", "fils", "fils"},
+	JPY: {"yen", "yen", "sen", "sen"},
+	KRW: {"won", "won", "jeon", "jeon"},
+	KWD: {"dinar", "dinars", "fils", "fils"},
+	MXN: {"peso", "pesos", "centavo", "centavos"},
+	NOK: {"krone", "kroner", "øre", "øre"},
+	NZD: {"dollar", "dollars", "cent", "cents"},
+	OMR: {"rial", "rials", "baisa", "baisa"},
+	QAR: {"riyal", "riyals", "dirham", "dirhams"},
+	RUB: {"ruble", "rubles", "kopeck", "kopecks"},
+	SAR: {"riyal", "riyals", "halala", "halalas"},
+	SEK: {"krona", "kronor", "öre", "öre"},
+	SGD: {"dollar", "dollars", "cent", "cents"},
+	USD: {"dollar", "dollars", "cent", "cents"},
+	ZAR: {"rand", "rand", "cent", "cents"},
+}
+
+var (
+	englishOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten",
+		"eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	englishTens   = []string{"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety"}
+	englishScales = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+		"sextillion", "septillion", "octillion", "nonillion", "decillion",
+	}
+)
+
+// EnglishSpeller spells amounts in English.
+type EnglishSpeller struct {
+	// Cheque writes the minor units as a fraction as on cheques, e.g. "and 56/100",
+	// rather than in words, e.g. "and fifty-six cents".
+	Cheque bool
+	// Names overrides EnglishUnitNames for the currencies it holds.
+	Names map[string]UnitNames
+}
+
+// Spell returns the amount in English words, rounded to the currency fraction using its rounding mode.
+// It returns ErrMissingCurrency for Money without a currency.
+func (s *EnglishSpeller) Spell(m *Money) (string, error) {
+	c := m.currency.get()
+	if c.Code == "" {
+		return "", fmt.Errorf("%w: can't name the units", ErrMissingCurrency)
+	}
+	names := s.names(c.Code)
+
+	amount := c.roundingMode().Round(m.amount, c.Fraction)
+	major := amount.Abs().Truncate(0)
+	minor := amount.Abs().Sub(major).Shift(c.Fraction).IntPart()
+
+	words, err := englishNumber(major.BigInt())
+	if err != nil {
+		return "", err
+	}
+	if unit := plural(major.BigInt().Cmp(big.NewInt(1)) == 0, names.Major, names.MajorPlural); unit != "" {
+		words += " " + unit
+	}
+
+	if c.Fraction > 0 {
+		switch {
+		case s.Cheque || names.Minor == "":
+			words += fmt.Sprintf(" and %0*d/%s", c.Fraction, minor, decimal.New(1, c.Fraction))
+		case minor != 0:
+			mw, _ := englishNumber(big.NewInt(minor))
+			words += " and " + mw + " " + plural(minor == 1, names.Minor, names.MinorPlural)
+		}
+	}
+
+	if amount.IsNegative() {
+		words = "minus " + words
+	}
+
+	r, size := utf8.DecodeRuneInString(words)
+	return string(unicode.ToUpper(r)) + words[size:], nil
+}
+
+// names returns the unit names of the currency falling back to its code.
+func (s *EnglishSpeller) names(code string) UnitNames {
+	if n, ok := s.Names[code]; ok {
+		return n
+	}
+	if n, ok := EnglishUnitNames[code]; ok {
+		return n
+	}
+
+	return UnitNames{Major: code, MajorPlural: code}
+}
+
+// plural returns the singular or the plural name.
+func plural(one bool, singular, plural string) string {
+	if one {
+		return singular
+	}
+
+	return plural
+}
+
+// englishNumber spells a non-negative integer in English, e.g. "one thousand two hundred thirty-four".
+func englishNumber(n *big.Int) (string, error) {
+	if n.Sign() == 0 {
+		return englishOnes[0], nil
+	}
+
+	var groups []int64
+	q, r, thousand := new(big.Int).Set(n), new(big.Int), big.NewInt(1000)
+	for q.Sign() > 0 {
+		q.QuoRem(q, thousand, r)
+		groups = append(groups, r.Int64())
+	}
+	if len(groups) > len(englishScales) {
+		return "", fmt.Errorf("%w: %s is too large to spell", ErrOverflow, n)
+	}
+
+	var words []string
+	for i := len(groups) - 1; i >= 0; i-- {
+		if groups[i] == 0 {
+			continue
+		}
+		words = append(words, englishHundreds(groups[i]))
+		if englishScales[i] != "" {
+			words = append(words, englishScales[i])
+		}
+	}
+
+	return strings.Join(words, " "), nil
+}
+
+// englishHundreds spells an integer between 1 and 999 in English.
+func englishHundreds(n int64) string {
+	var words []string
+	if n >= 100 {
+		words = append(words, englishOnes[n/100], "hundred")
+		n %= 100
+	}
+
+	switch {
+	case n >= 20 && n%10 != 0:
+		words = append(words, englishTens[n/10]+"-"+englishOnes[n%10])
+	case n >= 20:
+		words = append(words, englishTens[n/10])
+	case n > 0:
+		words = append(words, englishOnes[n])
+	}
+
+	return strings.Join(words, " ")
+}
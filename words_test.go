@@ -0,0 +1,117 @@
+package money
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestMoney_InWords(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		tag      language.Tag
+		expected string
+	}{
+		{"1234.56", USD, language.English, "One thousand two hundred thirty-four dollars and 56/100"},
+		{"1234.56", USD, language.AmericanEnglish, "One thousand two hundred thirty-four dollars and 56/100"},
+		{"1", USD, language.English, "One dollar and 00/100"},
+		{"0.05", USD, language.English, "Zero dollars and 05/100"},
+		{"-21", GBP, language.BritishEnglish, "Minus twenty-one pounds and 00/100"},
+		{"1000000", JPY, language.English, "One million yen"},
+		{"12.5", KWD, language.English, "Twelve dinars and 500/1000"},
+		{"1100000000000", EUR, language.English, "One trillion one hundred billion euros and 00/100"},
+		{"5", "XYZ", language.English, "Five XYZ and 00/100"},
+	}
+
+	for _, tc := range tcs {
+		r, err := New(tc.amount, tc.code).InWords(tc.tag)
+
+		if err != nil {
+			t.Errorf("Expected no error got %v", err)
+		}
+
+		if r != tc.expected {
+			t.Errorf("Expected %s %s in words to be %q got %q", tc.amount, tc.code, tc.expected, r)
+		}
+	}
+}
+
+func TestMoney_InWordsUnsupportedLanguage(t *testing.T) {
+	_, err := New(1, USD).InWords(language.German)
+
+	if !errors.Is(err, ErrUnsupportedLanguage) {
+		t.Errorf("Expected %v got %v", ErrUnsupportedLanguage, err)
+	}
+}
+
+func TestEnglishSpeller_Spell(t *testing.T) {
+	tcs := []struct {
+		amount   string
+		code     string
+		expected string
+	}{
+		{"1234.56", USD, "One thousand two hundred thirty-four dollars and fifty-six cents"},
+		{"1.01", GBP, "One pound and one penny"},
+		{"2.02", GBP, "Two pounds and two pence"},
+		{"1", EUR, "One euro"},
+		{"1234", IRR, "One thousand two hundred thirty-four rials"},
+		{"12.5", OMR, "Twelve rials and five hundred baisa"},
+		{"100", JPY, "One hundred yen"},
+		{"1.999", USD, "One dollar and ninety-nine cents"},
+		{"5.25", "XYZ", "Five XYZ and 25/100"},
+	}
+
+	s := &EnglishSpeller{}
+	for _, tc := range tcs {
+		r, err := s.Spell(New(tc.amount, tc.code))
+
+		if err != nil {
+			t.Errorf("Expected no error got %v", err)
+		}
+
+		if r != tc.expected {
+			t.Errorf("Expected %s %s in words to be %q got %q", tc.amount, tc.code, tc.expected, r)
+		}
+	}
+}
+
+func TestEnglishSpeller_SpellNames(t *testing.T) {
+	s := &EnglishSpeller{Names: map[string]UnitNames{USD: {"buck", "bucks", "", ""}}}
+	r, _ := s.Spell(New("2.5", USD))
+
+	if r != "Two bucks and 50/100" {
+		t.Errorf("Expected %q got %q", "Two bucks and 50/100", r)
+	}
+
+	s = &EnglishSpeller{Names: map[string]UnitNames{USD: {}}}
+	r, _ = s.Spell(New("2.5", USD))
+
+	if r != "Two and 50/100" {
+		t.Errorf("Expected %q got %q", "Two and 50/100", r)
+	}
+}
+
+func TestEnglishSpeller_SpellWithoutCurrency(t *testing.T) {
+	for _, m := range []*Money{New(0, ""), Zero, {}} {
+		if _, err := m.InWords(language.English); !errors.Is(err, ErrMissingCurrency) {
+			t.Errorf("Expected %v got %v", ErrMissingCurrency, err)
+		}
+	}
+}
+
+func TestRegisterSpeller(t *testing.T) {
+	RegisterSpeller(language.German, &EnglishSpeller{})
+	defer func() {
+		spellersMu.Lock()
+		delete(spellers, language.German)
+		spellersMu.Unlock()
+	}()
+
+	r, err := New(1, EUR).InWords(language.MustParse("de-AT"))
+
+	if err != nil || r != "One euro" {
+		t.Errorf("Expected %q got %q, %v", "One euro", r, err)
+	}
+}
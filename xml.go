@@ -0,0 +1,132 @@
+package money
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// XMLOptions configures the XML encoding of Money.
+type XMLOptions struct {
+	// CurrencyAttr is the name of the attribute holding the currency code, "Ccy" as in ISO 20022 when empty.
+	CurrencyAttr string
+	// Strict makes decoding reject unknown currencies and amounts more precise than the currency fraction.
+	Strict bool
+}
+
+// currencyAttr returns the name of the currency attribute.
+func (o XMLOptions) currencyAttr() string {
+	if o.CurrencyAttr == "" {
+		return "Ccy"
+	}
+
+	return o.CurrencyAttr
+}
+
+// Marshal writes the currency code as an attribute and the exact amount as character data,
+// e.g. <Amt Ccy="EUR">12.50</Amt>.
+func (o XMLOptions) Marshal(e *xml.Encoder, start xml.StartElement, m Money) error {
+	if m.currency == nil {
+		m.currency = newCurrency("").get()
+	}
+
+	if m.currency.Code != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: o.currencyAttr()}, Value: m.currency.Code})
+	}
+
+	return e.EncodeElement(m.AmountString(), start)
+}
+
+// Unmarshal reads the form written by Marshal.
+// It returns ErrMissingCurrency if the element has no currency attribute.
+func (o XMLOptions) Unmarshal(d *xml.Decoder, start xml.StartElement, m *Money) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+
+	code := ""
+	for _, attr := range start.Attr {
+		if attr.Name.Local == o.currencyAttr() {
+			code = attr.Value
+		}
+	}
+	if code == "" {
+		return fmt.Errorf("%w: no %s attribute on <%s>", ErrMissingCurrency, o.currencyAttr(), start.Name.Local)
+	}
+
+	amount, err := decimal.NewFromString(strings.TrimSpace(s))
+	if err != nil {
+		return &AmountError{Value: s, Err: fmt.Errorf("%w: %v", ErrInvalidAmount, err)}
+	}
+
+	c := newCurrency(code)
+	if o.Strict {
+		if c = GetCurrency(code); c == nil {
+			return fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+		}
+		if !amount.Shift(c.Fraction).IsInteger() {
+			return fmt.Errorf("%w: %s has more than %d decimal places for %s", ErrPrecisionLoss, s, c.Fraction, c.Code)
+		}
+	}
+
+	*m = Money{amount: amount, currency: c.get()}
+	return nil
+}
+
+// MarshalXML is implementation of xml.Marshaler, it uses the default XMLOptions,
+// e.g. <Amt Ccy="EUR">12.50</Amt>. Use XMLAs for other options.
+func (m Money) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return XMLOptions{}.Marshal(e, start, m)
+}
+
+// UnmarshalXML is implementation of xml.Unmarshaler, it uses the default XMLOptions.
+func (m *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return XMLOptions{}.Unmarshal(d, start, m)
+}
+
+// XMLShape gives the options used by XMLAs.
+type XMLShape interface {
+	XMLOptions() XMLOptions
+}
+
+// Shapes of common XML formats for XMLAs.
+type (
+	// ISO20022Shape uses the Ccy attribute, e.g. <Amt Ccy="EUR">12.50</Amt>.
+	ISO20022Shape struct{}
+	// UBLShape uses the currencyID attribute, e.g. <cbc:PayableAmount currencyID="EUR">12.50</cbc:PayableAmount>.
+	UBLShape struct{}
+)
+
+func (ISO20022Shape) XMLOptions() XMLOptions { return XMLOptions{CurrencyAttr: "Ccy"} }
+func (UBLShape) XMLOptions() XMLOptions      { return XMLOptions{CurrencyAttr: "currencyID"} }
+
+// XMLAs wraps Money to encode it as XML with the options of the shape,
+// e.g. a struct field of type money.XMLAs[money.UBLShape]. A nil Money is omitted.
+type XMLAs[S XMLShape] struct {
+	*Money
+}
+
+// MarshalXML is implementation of xml.Marshaler
+func (x XMLAs[S]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if x.Money == nil {
+		return nil
+	}
+
+	var s S
+	return s.XMLOptions().Marshal(e, start, *x.Money)
+}
+
+// UnmarshalXML is implementation of xml.Unmarshaler
+func (x *XMLAs[S]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s S
+	m := &Money{}
+	if err := s.XMLOptions().Unmarshal(d, start, m); err != nil {
+		return err
+	}
+
+	x.Money = m
+	return nil
+}
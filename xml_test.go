@@ -0,0 +1,141 @@
+package money
+
+import (
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+type xmlInvoice struct {
+	XMLName xml.Name `xml:"Invoice"`
+	Amt     *Money   `xml:"Amt"`
+	Tax     Money    `xml:"Tax"`
+}
+
+func TestMoney_MarshalXML(t *testing.T) {
+	given := xmlInvoice{Amt: New("12.5", EUR), Tax: *New("0.125", KWD)}
+	expected := `<Invoice><Amt Ccy="EUR">12.50</Amt><Tax Ccy="KWD">0.125</Tax></Invoice>`
+
+	b, err := xml.Marshal(given)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+
+	b, _ = xml.Marshal(Money{amount: decimal.NewFromInt(0)})
+	expected = `<Money>0.00</Money>`
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+
+}
+
+// strictUBLShape is a custom XMLShape.
+type strictUBLShape struct{}
+
+func (strictUBLShape) XMLOptions() XMLOptions {
+	return XMLOptions{CurrencyAttr: "currencyID", Strict: true}
+}
+
+type xmlMixedInvoice struct {
+	XMLName xml.Name              `xml:"Invoice"`
+	Amt     XMLAs[ISO20022Shape]  `xml:"Amt"`
+	Payable XMLAs[UBLShape]       `xml:"PayableAmount"`
+	Tax     XMLAs[strictUBLShape] `xml:"TaxAmount"`
+	Charge  XMLAs[UBLShape]       `xml:"ChargeAmount"`
+}
+
+func TestXMLAs(t *testing.T) {
+	given := xmlMixedInvoice{
+		Amt:     XMLAs[ISO20022Shape]{New("12.5", EUR)},
+		Payable: XMLAs[UBLShape]{New("1234.5", USD)},
+		Tax:     XMLAs[strictUBLShape]{New("0.125", KWD)},
+	}
+	expected := `<Invoice><Amt Ccy="EUR">12.50</Amt><PayableAmount currencyID="USD">1234.50</PayableAmount><TaxAmount currencyID="KWD">0.125</TaxAmount></Invoice>`
+
+	b, err := xml.Marshal(given)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if string(b) != expected {
+		t.Errorf("Expected %s got %s", expected, string(b))
+	}
+
+	var r xmlMixedInvoice
+	if err := xml.Unmarshal(b, &r); err != nil {
+		t.Error(err)
+	}
+
+	if r.Amt.String() != "12.50 EUR" || r.Payable.String() != "1234.50 USD" || r.Tax.String() != "0.125 KWD" || r.Charge.Money != nil {
+		t.Errorf("Expected %s to decode to %+v got %+v", expected, given, r)
+	}
+}
+
+func TestMoney_UnmarshalXML(t *testing.T) {
+	tcs := []struct {
+		given    string
+		expected string
+	}{
+		{`<Invoice><Amt Ccy="EUR">12.50</Amt></Invoice>`, "12.50 EUR"},
+		{`<Invoice><Amt Ccy="usd"> 12345678901234567890.125 </Amt></Invoice>`, "12345678901234567890.125 USD"},
+		{`<Invoice><Amt Ccy="JPY">-1234</Amt></Invoice>`, "-1234 JPY"},
+	}
+
+	for _, tc := range tcs {
+		var r xmlInvoice
+		if err := xml.Unmarshal([]byte(tc.given), &r); err != nil {
+			t.Error(err)
+		}
+
+		if r.Amt.String() != tc.expected {
+			t.Errorf("Expected %s got %s", tc.expected, r.Amt)
+		}
+	}
+}
+
+func TestMoney_UnmarshalXMLErrors(t *testing.T) {
+	tcs := []struct {
+		given  string
+		strict bool
+		err    error
+	}{
+		{`<Amt>12.50</Amt>`, false, ErrMissingCurrency},
+		{`<Amt Ccy="EUR">twelve</Amt>`, false, ErrInvalidAmount},
+		{`<Amt Ccy="XYZ">12.50</Amt>`, false, nil},
+		{`<Amt Ccy="XYZ">12.50</Amt>`, true, ErrUnknownCurrency},
+		{`<Amt Ccy="EUR">12.505</Amt>`, false, nil},
+		{`<Amt Ccy="EUR">12.505</Amt>`, true, ErrPrecisionLoss},
+		{`<Amt Ccy="EUR">12.5000</Amt>`, true, nil},
+		{`<Amt Ccy="JPY">12.5</Amt>`, true, ErrPrecisionLoss},
+	}
+
+	var r xmlMixedInvoice
+	err := xml.Unmarshal([]byte(`<Invoice><TaxAmount currencyID="KWD">0.1255</TaxAmount></Invoice>`), &r)
+	if !errors.Is(err, ErrPrecisionLoss) {
+		t.Errorf("Expected strict shape to fail with %v got %v", ErrPrecisionLoss, err)
+	}
+
+	err = xml.Unmarshal([]byte(`<Invoice><PayableAmount Ccy="USD">1</PayableAmount></Invoice>`), &r)
+	if !errors.Is(err, ErrMissingCurrency) {
+		t.Errorf("Expected UBL shape to fail with %v got %v", ErrMissingCurrency, err)
+	}
+
+	for _, tc := range tcs {
+		var m Money
+		opts := XMLOptions{Strict: tc.strict}
+		d := xml.NewDecoder(strings.NewReader(tc.given))
+		start, _ := d.Token()
+		err := opts.Unmarshal(d, start.(xml.StartElement), &m)
+
+		if !errors.Is(err, tc.err) {
+			t.Errorf("Expected %s to fail with %v got %v", tc.given, tc.err, err)
+		}
+	}
+}